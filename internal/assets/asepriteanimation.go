@@ -2,7 +2,9 @@ package assets
 
 import (
 	"encoding/json"
+	"fmt"
 	"image"
+	"path"
 
 	"github.com/hajimehoshi/ebiten/v2"
 )
@@ -83,6 +85,26 @@ func (a *Animation) LoadFromJSON(jsonPath, tilesetPath string) error {
 	return nil
 }
 
+// LoadAnimationFolder loads the asset.json and asset.png inside a sprite
+// folder, used by the data driven definitions
+func LoadAnimationFolder(folder string, frameDelay float64) (*Animation, error) {
+	animation := NewAnimation(frameDelay)
+
+	err := animation.LoadFromJSON(
+		path.Join(folder, "asset.json"),
+		path.Join(folder, "asset.png"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(animation.Frames) == 0 {
+		return nil, fmt.Errorf("%s has no frames", folder)
+	}
+
+	return animation, nil
+}
+
 func (a *Animation) Update(deltaTime float64) {
 	a.FrameTimer += deltaTime
 	if a.FrameTimer >= a.FrameDelay {
@@ -3,7 +3,18 @@ package assets
 import (
 	"embed"
 	_ "embed"
+	"io/fs"
 )
 
 //go:embed assets/*
 var assets embed.FS
+
+// ReadFile reads an embedded file, used by the data driven definitions
+func ReadFile(path string) ([]byte, error) {
+	return assets.ReadFile(path)
+}
+
+// ReadDir lists an embedded directory
+func ReadDir(path string) ([]fs.DirEntry, error) {
+	return assets.ReadDir(path)
+}
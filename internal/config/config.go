@@ -5,3 +5,13 @@ import "os"
 func IsDebugEnv() bool {
 	return os.Getenv("env") == "debug"
 }
+
+// Timeline is the wave timeline used by the runs, swapped per stage or
+// difficulty
+func Timeline() string {
+	if timeline := os.Getenv("timeline"); timeline != "" {
+		return timeline
+	}
+
+	return "default"
+}
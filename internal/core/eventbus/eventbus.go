@@ -1,23 +1,54 @@
 package eventbus
 
 type EventBus struct {
-	subscribers map[string][]func(interface{})
+	subscribers map[string][]subscriber
+	nextID      int
+}
+
+type subscriber struct {
+	id       int
+	callback func(interface{})
+}
+
+// Subscription identifies a callback to remove with Unsubscribe
+type Subscription struct {
+	event string
+	id    int
 }
 
 func NewEventBus() *EventBus {
 	return &EventBus{
-		subscribers: make(map[string][]func(interface{})),
+		subscribers: make(map[string][]subscriber),
 	}
 }
 
-func (eb *EventBus) Subscribe(event string, callback func(interface{})) {
-	eb.subscribers[event] = append(eb.subscribers[event], callback)
+func (eb *EventBus) Subscribe(event string, callback func(interface{})) Subscription {
+	eb.nextID++
+	eb.subscribers[event] = append(eb.subscribers[event], subscriber{id: eb.nextID, callback: callback})
+
+	return Subscription{event: event, id: eb.nextID}
+}
+
+// Unsubscribe removes the callbacks, the list is copied so an event being
+// published still reaches every callback it started with
+func (eb *EventBus) Unsubscribe(subscriptions ...Subscription) {
+	for _, s := range subscriptions {
+		var kept []subscriber
+
+		for _, sub := range eb.subscribers[s.event] {
+			if sub.id != s.id {
+				kept = append(kept, sub)
+			}
+		}
+
+		eb.subscribers[s.event] = kept
+	}
 }
 
 func (eb *EventBus) Publish(event string, data interface{}) {
 	if handlers, found := eb.subscribers[event]; found {
 		for _, handler := range handlers {
-			handler(data)
+			handler.callback(data)
 		}
 	}
 }
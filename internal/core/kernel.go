@@ -3,6 +3,7 @@ package core
 
 import (
 	"game/internal/core/eventbus"
+	"game/internal/core/profiler"
 	"sync"
 	"time"
 )
@@ -14,6 +15,7 @@ const (
 
 type GameKernel struct {
 	EventBus    *eventbus.EventBus
+	Profiler    *profiler.Profiler
 	TimeScale   float64
 	DeltaTime   float64
 	accumulator float64
@@ -24,6 +26,7 @@ type GameKernel struct {
 func NewGameKernel() *GameKernel {
 	return &GameKernel{
 		EventBus:   eventbus.NewEventBus(),
+		Profiler:   profiler.New(),
 		TimeScale:  1.0,
 		lastUpdate: time.Now(),
 	}
@@ -13,6 +13,12 @@ type Plugin interface {
 	Draw(screen *ebiten.Image)
 }
 
+// Closer is implemented by the plugins holding event subscriptions,
+// UnregisterAll closes them so the plugins of a finished run stop listening
+type Closer interface {
+	Close()
+}
+
 type PluginManager struct {
 	plugins map[string]RegisteredPlugin
 }
@@ -52,6 +58,12 @@ func (pm *PluginManager) DrawAll(screen *ebiten.Image) {
 }
 
 func (pm *PluginManager) UnregisterAll() {
+	for _, registered := range pm.plugins {
+		if closer, ok := registered.plugin.(Closer); ok {
+			closer.Close()
+		}
+	}
+
 	pm.plugins = make(map[string]RegisteredPlugin)
 }
 
@@ -0,0 +1,58 @@
+package profiler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Profiler collects named gauges reported by the plugins, the game prints
+// them with the per second stats
+type Profiler struct {
+	mu     sync.Mutex
+	gauges map[string]float64
+}
+
+func New() *Profiler {
+	return &Profiler{
+		gauges: map[string]float64{},
+	}
+}
+
+// Set records the latest value of a gauge
+func (p *Profiler) Set(name string, value float64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.gauges[name] = value
+}
+
+// Reset drops every gauge, used when a run ends
+func (p *Profiler) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.gauges = map[string]float64{}
+}
+
+// String returns the gauges sorted by name, one per line
+func (p *Profiler) String() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	names := make([]string, 0, len(p.gauges))
+	for name := range p.gauges {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var b strings.Builder
+
+	for _, name := range names {
+		fmt.Fprintf(&b, "%s: %g\n", name, p.gauges[name])
+	}
+
+	return b.String()
+}
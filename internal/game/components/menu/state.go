@@ -3,6 +3,7 @@ package menu
 import (
 	"game/internal/core"
 	menu "game/internal/plugins/menu/main"
+	"game/internal/plugins/playing/combatlog"
 
 	"github.com/hajimehoshi/ebiten/v2"
 )
@@ -21,13 +22,17 @@ func NewComponentMenuState(kernel *core.GameKernel) *ComponentMenuState {
 	kernel.EventBus.Subscribe("GameOver", func(data interface{}) {
 		pluginManager.UnregisterAll()
 
-		menuPlugin := menu.NewMenuPlugin(kernel)
+		menuPlugin = menu.NewMenuPlugin(kernel)
 
 		pluginManager.Register(menuPlugin, 0)
 
 		menuPlugin.Init(kernel)
 	})
 
+	kernel.EventBus.Subscribe("RunSummary", func(data interface{}) {
+		menuPlugin.ShowSummary(data.(combatlog.Summary))
+	})
+
 	menuPlugin.Init(kernel)
 
 	return &ComponentMenuState{kernel: kernel, pluginManager: pluginManager}
@@ -7,8 +7,11 @@ import (
 	"game/internal/plugins/playing/camera"
 	"game/internal/plugins/playing/chooseability"
 	"game/internal/plugins/playing/combat"
+	"game/internal/plugins/playing/combatlog"
 	"game/internal/plugins/playing/enemy"
-	"game/internal/plugins/playing/experience"
+	"game/internal/plugins/playing/hostile"
+	"game/internal/plugins/playing/passive"
+	"game/internal/plugins/playing/pickup"
 	"game/internal/plugins/playing/player"
 	"game/internal/plugins/playing/scenario"
 	"game/internal/plugins/playing/stats"
@@ -31,6 +34,11 @@ type ComponentPlayingState struct {
 	kernel               *core.GameKernel
 	pluginManagerByState map[State]*core.PluginManager
 	state                State
+
+	// Plugins of the current run, the choices are routed to them
+	abilityPlugin *ability.AbilityPlugin
+	passivePlugin *passive.PassivePlugin
+	combatLog     *combatlog.CombatLogPlugin
 }
 
 func NewComponentPlayingState(kernel *core.GameKernel) *ComponentPlayingState {
@@ -42,6 +50,7 @@ func NewComponentPlayingState(kernel *core.GameKernel) *ComponentPlayingState {
 
 	kernel.EventBus.Subscribe("StartGame", func(data interface{}) {
 		pluginManagerByState[Playing].UnregisterAll()
+		kernel.Profiler.Reset()
 
 		character := data.(playerentities.Character)
 
@@ -49,55 +58,85 @@ func NewComponentPlayingState(kernel *core.GameKernel) *ComponentPlayingState {
 		playerPlugin := player.NewPlayerPlugin(pluginManagerByState[Playing], character)
 		cameraPlugin := camera.NewCameraPlugin(playerPlugin)
 		enemyPlugin := enemy.NewEnemyPlugin(playerPlugin, pluginManagerByState[Playing])
+		hostilePlugin := hostile.NewHostileProjectilePlugin(pluginManagerByState[Playing])
 		combatPlugin := combat.NewCombatPlugin(enemyPlugin, pluginManagerByState[Playing])
+		combatLogPlugin := combatlog.NewCombatLogPlugin()
 		statsPlugin := stats.NewStatsPlugin(pluginManagerByState[Playing])
 		abilityPlugin := ability.NewAbilityPlugin(pluginManagerByState[Playing])
-		experiencePlugin := experience.NewExperiencePlugin(pluginManagerByState[Playing])
+		pickupPlugin := pickup.NewPickupPlugin(pluginManagerByState[Playing])
 		scenarioPlugin := scenario.New(pluginManagerByState[Playing])
+		passivePlugin := passive.NewPassivePlugin(pluginManagerByState[Playing])
 
 		pluginManagerByState[Playing].Register(scenarioPlugin, 1)
 		pluginManagerByState[Playing].Register(abilityPlugin, 10)
+		pluginManagerByState[Playing].Register(passivePlugin, 15)
 		pluginManagerByState[Playing].Register(playerPlugin, 20)
-		pluginManagerByState[Playing].Register(experiencePlugin, 30)
+		pluginManagerByState[Playing].Register(pickupPlugin, 30)
 		pluginManagerByState[Playing].Register(enemyPlugin, 40)
+		pluginManagerByState[Playing].Register(hostilePlugin, 45)
 		pluginManagerByState[Playing].Register(combatPlugin, 50)
+		pluginManagerByState[Playing].Register(combatLogPlugin, 55)
 		pluginManagerByState[Playing].Register(cameraPlugin, 60)
 		pluginManagerByState[Playing].Register(statsPlugin, 70)
 
 		playerPlugin.Init(kernel)
-		experiencePlugin.Init(kernel)
+		pickupPlugin.Init(kernel)
 		enemyPlugin.Init(kernel)
+		hostilePlugin.Init(kernel)
 		combatPlugin.Init(kernel)
+		combatLogPlugin.Init(kernel)
 		cameraPlugin.Init(kernel)
 		statsPlugin.Init(kernel)
 		abilityPlugin.Init(kernel)
 		scenarioPlugin.Init(kernel)
+		passivePlugin.Init(kernel)
+
+		componentPlayingState.abilityPlugin = abilityPlugin
+		componentPlayingState.passivePlugin = passivePlugin
+		componentPlayingState.combatLog = combatLogPlugin
 
 		// ChooseAbility plugins
-		chooseabilityPlugin := chooseability.NewChooseAbilityPlugin(pluginManagerByState[ChooseAbility])
+		chooseabilityPlugin := chooseability.NewChooseAbilityPlugin(pluginManagerByState[Playing])
 
 		pluginManagerByState[ChooseAbility].Register(chooseabilityPlugin, 0)
 
 		chooseabilityPlugin.Init(kernel)
 
-		// Subscribers
-		kernel.EventBus.Subscribe("ChoosingAbility", func(data interface{}) {
-			fmt.Println("ChoosingAbility")
+		kernel.EventBus.Publish(
+			"NewAbility",
+			abilityPlugin.GetAvailableAbilitiesByName(character.Ability))
+	})
 
-			componentPlayingState.SetState(ChooseAbility)
-		})
+	// Subscribed once, a new run only replaces the plugins they reach
+	kernel.EventBus.Subscribe("ChoosingAbility", func(data interface{}) {
+		fmt.Println("ChoosingAbility")
 
-		kernel.EventBus.Subscribe("NewAbility", func(a interface{}) {
-			ability := a.(entitiesability.Ability)
-			ability.SetPluginManager(pluginManagerByState[Playing])
-			abilityPlugin.AcquireAbility(ability)
+		componentPlayingState.SetState(ChooseAbility)
+	})
 
-			componentPlayingState.SetState(Playing)
-		})
+	kernel.EventBus.Subscribe("NewAbility", func(a interface{}) {
+		ability := a.(entitiesability.Ability)
+		ability.SetPluginManager(pluginManagerByState[Playing])
+		componentPlayingState.abilityPlugin.AcquireAbility(ability)
 
-		kernel.EventBus.Publish(
-			"NewAbility",
-			abilityPlugin.GetAvailableAbilitiesByName(character.Ability))
+		componentPlayingState.SetState(Playing)
+	})
+
+	kernel.EventBus.Subscribe("NewPassiveItem", func(id interface{}) {
+		componentPlayingState.passivePlugin.Acquire(id.(string))
+
+		componentPlayingState.SetState(Playing)
+	})
+
+	kernel.EventBus.Subscribe("ChoiceSkipped", func(data interface{}) {
+		componentPlayingState.SetState(Playing)
+	})
+
+	// The menu shows the summary of the run that just ended
+	kernel.EventBus.Subscribe("GameOver", func(data interface{}) {
+		if componentPlayingState.combatLog != nil {
+			kernel.EventBus.Publish("RunSummary", componentPlayingState.combatLog.Summary())
+		}
 	})
 
 	componentPlayingState.pluginManagerByState = pluginManagerByState
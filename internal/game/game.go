@@ -3,6 +3,7 @@ package game
 
 import (
 	"fmt"
+	"game/internal/config"
 	"game/internal/constants"
 	"game/internal/core"
 	"game/internal/game/components/menu"
@@ -78,7 +79,11 @@ func (g *Game) Draw(screen *ebiten.Image) {
 	if now.Sub(g.perSec) >= time.Second {
 		fmt.Printf("TPS: %.2f, FPS: %.2f", ebiten.ActualTPS(), ebiten.ActualFPS())
 		fmt.Printf("Update() was called in this sec: %d times", g.updateCount)
-		fmt.Printf("Draw() was called in this sec: %d times\n\n", g.drawCount)
+		fmt.Printf("Draw() was called in this sec: %d times\n", g.drawCount)
+
+		if config.IsDebugEnv() {
+			fmt.Printf("%s\n", g.kernel.Profiler)
+		}
 
 		g.updateCount = 0
 		g.drawCount = 0
@@ -0,0 +1,139 @@
+package shapes
+
+import "math"
+
+// epsilon absorbs the rounding of rotated shapes, so touching shapes still
+// intersect
+const epsilon = 1e-9
+
+// Intersects tells whether the two shapes overlap, touching counts
+func Intersects(a, b Shape) bool {
+	if !overlaps(a.Bounds(), b.Bounds()) {
+		return false
+	}
+
+	for _, ha := range a.hulls() {
+		for _, hb := range b.hulls() {
+			if ha.intersects(hb) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Contains tells whether the point is inside the shape
+func Contains(s Shape, x, y float64) bool {
+	return Intersects(s, Circle{X: x, Y: y})
+}
+
+func overlaps(a, b AABB) bool {
+	return a.X <= b.X+b.Width+epsilon && a.X+a.Width+epsilon >= b.X &&
+		a.Y <= b.Y+b.Height+epsilon && a.Y+a.Height+epsilon >= b.Y
+}
+
+// intersects checks one hull inside the other first, otherwise the hulls
+// overlap when their polygons are closer than the sum of the radiuses
+func (h hull) intersects(o hull) bool {
+	if h.contains(o.points[0]) || o.contains(h.points[0]) {
+		return true
+	}
+
+	radius := h.radius + o.radius
+	distance := math.Inf(1)
+
+	for _, a := range h.edges() {
+		for _, b := range o.edges() {
+			distance = math.Min(distance, segmentDistance(a[0], a[1], b[0], b[1]))
+
+			if distance <= radius+epsilon {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// contains works for both windings, points and segments contain nothing
+func (h hull) contains(p Point) bool {
+	if len(h.points) < 3 {
+		return false
+	}
+
+	sign := 0.0
+
+	for _, edge := range h.edges() {
+		c := cross(edge[0], edge[1], p)
+
+		if c == 0 {
+			continue
+		}
+
+		if sign != 0 && (c > 0) != (sign > 0) {
+			return false
+		}
+
+		sign = c
+	}
+
+	return true
+}
+
+// edges of a single point hull is the point itself
+func (h hull) edges() [][2]Point {
+	n := len(h.points)
+
+	switch n {
+	case 1:
+		return [][2]Point{{h.points[0], h.points[0]}}
+	case 2:
+		return [][2]Point{{h.points[0], h.points[1]}}
+	}
+
+	edges := make([][2]Point, 0, n)
+	for i := range h.points {
+		edges = append(edges, [2]Point{h.points[i], h.points[(i+1)%n]})
+	}
+
+	return edges
+}
+
+func segmentDistance(a1, a2, b1, b2 Point) float64 {
+	if segmentsCross(a1, a2, b1, b2) {
+		return 0
+	}
+
+	return math.Min(
+		math.Min(pointSegmentDistance(a1, b1, b2), pointSegmentDistance(a2, b1, b2)),
+		math.Min(pointSegmentDistance(b1, a1, a2), pointSegmentDistance(b2, a1, a2)))
+}
+
+// segmentsCross only detects proper crossings, touching segments have a zero
+// point distance instead
+func segmentsCross(a1, a2, b1, b2 Point) bool {
+	d1 := cross(a1, a2, b1)
+	d2 := cross(a1, a2, b2)
+	d3 := cross(b1, b2, a1)
+	d4 := cross(b1, b2, a2)
+
+	return d1*d2 < 0 && d3*d4 < 0
+}
+
+func pointSegmentDistance(p, a, b Point) float64 {
+	dx, dy := b.X-a.X, b.Y-a.Y
+	length := dx*dx + dy*dy
+
+	t := 0.0
+	if length > 0 {
+		t = math.Max(0, math.Min(1, ((p.X-a.X)*dx+(p.Y-a.Y)*dy)/length))
+	}
+
+	return math.Hypot(p.X-(a.X+t*dx), p.Y-(a.Y+t*dy))
+}
+
+// cross is positive when p is on the left of the a to b direction
+func cross(a, b, p Point) float64 {
+	return (b.X-a.X)*(p.Y-a.Y) - (b.Y-a.Y)*(p.X-a.X)
+}
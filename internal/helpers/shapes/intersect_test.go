@@ -0,0 +1,177 @@
+package shapes
+
+import (
+	"math"
+	"testing"
+)
+
+// diamond is a 2x2 square turned by 45 degrees, its corners are sqrt(2) away
+// from the origin on both axes
+var diamond = OrientedRect{Width: 2, Height: 2, Angle: math.Pi / 4}
+
+// cone points right from the origin, opened 45 degrees on each side
+var cone = Cone{Radius: 2, Spread: math.Pi / 4}
+
+// capsule lies on the X axis from 0 to 4
+var capsule = Capsule{X2: 4, Radius: 1}
+
+func TestIntersects(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Shape
+		want bool
+	}{
+		// Circle
+		{"circle circle touching", Circle{0, 0, 1}, Circle{2, 0, 1}, true},
+		{"circle circle overlapping", Circle{0, 0, 1}, Circle{1, 0, 1}, true},
+		{"circle circle disjoint", Circle{0, 0, 1}, Circle{3, 0, 1}, false},
+		{"circle circle contained", Circle{0, 0, 1}, Circle{0.2, 0, 0.5}, true},
+
+		{"circle aabb touching", Circle{0, 0, 1}, AABB{1, -1, 2, 2}, true},
+		{"circle aabb overlapping", Circle{0, 0, 1}, AABB{0.5, -1, 2, 2}, true},
+		{"circle aabb disjoint", Circle{0, 0, 1}, AABB{1.5, -1, 2, 2}, false},
+		{"circle aabb disjoint near corner", Circle{0, 0, 1}, AABB{0.8, 0.8, 1, 1}, false},
+		{"circle aabb contained", Circle{0, 0, 1}, AABB{-2, -2, 4, 4}, true},
+		{"aabb contained in circle", Circle{0, 0, 2}, AABB{-0.5, -0.5, 1, 1}, true},
+
+		{"circle oriented touching", Circle{math.Sqrt2 + 1, 0, 1}, diamond, true},
+		{"circle oriented overlapping", Circle{math.Sqrt2 + 0.5, 0, 1}, diamond, true},
+		{"circle oriented disjoint", Circle{math.Sqrt2 + 1.1, 0, 1}, diamond, false},
+		{"circle oriented disjoint near edge", Circle{1.2, 1.2, 0.3}, diamond, false},
+		{"circle oriented contained", Circle{0, 0, 0.5}, diamond, true},
+
+		{"circle cone touching apex", Circle{-1, 0, 1}, cone, true},
+		{"circle cone overlapping", Circle{1, 0, 0.5}, cone, true},
+		{"circle cone disjoint", Circle{-1.5, 0, 1}, cone, false},
+		{"circle cone disjoint near edge", Circle{0.5, -1.5, 0.3}, cone, false},
+		{"circle cone contained", Circle{1, 0, 0.2}, cone, true},
+
+		{"circle capsule touching", Circle{2, 3, 2}, capsule, true},
+		{"circle capsule touching cap", Circle{6, 0, 1}, capsule, true},
+		{"circle capsule overlapping", Circle{2, 2, 2}, capsule, true},
+		{"circle capsule disjoint", Circle{2, 3.5, 2}, capsule, false},
+		{"circle capsule contained", Circle{2, 0, 0.5}, capsule, true},
+
+		// AABB
+		{"aabb aabb touching", AABB{0, 0, 2, 2}, AABB{2, 0, 2, 2}, true},
+		{"aabb aabb touching corner", AABB{0, 0, 2, 2}, AABB{2, 2, 1, 1}, true},
+		{"aabb aabb overlapping", AABB{0, 0, 2, 2}, AABB{1, 1, 2, 2}, true},
+		{"aabb aabb disjoint", AABB{0, 0, 2, 2}, AABB{3, 0, 1, 1}, false},
+		{"aabb aabb contained", AABB{0, 0, 2, 2}, AABB{0.5, 0.5, 1, 1}, true},
+
+		{"aabb oriented touching", AABB{math.Sqrt2, -1, 1, 2}, diamond, true},
+		{"aabb oriented overlapping", AABB{1, -0.5, 1, 1}, diamond, true},
+		{"aabb oriented disjoint near edge", AABB{1, 1, 1, 1}, diamond, false},
+		{"aabb oriented contained", AABB{-0.5, -0.5, 1, 1}, diamond, true},
+		{"oriented contained in aabb", AABB{-2, -2, 4, 4}, diamond, true},
+
+		{"aabb cone touching apex", AABB{-1, -1, 1, 2}, cone, true},
+		{"aabb cone overlapping", AABB{1, -0.25, 0.5, 0.5}, cone, true},
+		{"aabb cone disjoint near edge", AABB{0.2, -1.4, 0.4, 0.4}, cone, false},
+		{"aabb cone contained", AABB{0.9, -0.1, 0.2, 0.2}, cone, true},
+
+		{"aabb capsule touching", AABB{1, 1, 2, 1}, capsule, true},
+		{"aabb capsule overlapping", AABB{1, 0.5, 2, 1}, capsule, true},
+		{"aabb capsule disjoint near cap", AABB{5, 1, 1, 1}, capsule, false},
+		{"aabb capsule contained", AABB{1, -0.5, 1, 1}, capsule, true},
+		{"capsule contained in aabb", AABB{-2, -2, 8, 4}, capsule, true},
+
+		// OrientedRect
+		{"oriented oriented touching", diamond, OrientedRect{2 * math.Sqrt2, 0, 2, 2, math.Pi / 4}, true},
+		{"oriented oriented overlapping", diamond, OrientedRect{2, 0, 2, 2, 0}, true},
+		{"oriented oriented disjoint", diamond, OrientedRect{3, 0, 2, 2, math.Pi / 4}, false},
+		{"oriented oriented contained", diamond, OrientedRect{0, 0, 1, 1, math.Pi / 4}, true},
+
+		{"oriented cone touching apex", diamond, Cone{math.Sqrt2, 0, 2, 0, math.Pi / 4}, true},
+		{"oriented cone overlapping", diamond, Cone{math.Sqrt2 + 0.5, 0, 2, math.Pi, math.Pi / 4}, true},
+		{"oriented cone disjoint", diamond, Cone{2, 0, 2, 0, math.Pi / 4}, false},
+		{"oriented cone contained", diamond, Cone{0, 0, 0.5, 0, math.Pi / 4}, true},
+
+		{"oriented capsule touching", diamond, Capsule{math.Sqrt2 + 1, 0, 4, 0, 1}, true},
+		{"oriented capsule overlapping", diamond, Capsule{2, 0, 4, 0, 1}, true},
+		{"oriented capsule disjoint", diamond, Capsule{3, 0, 4, 0, 1}, false},
+		{"oriented capsule contained", diamond, Capsule{-0.3, 0, 0.3, 0, 0.2}, true},
+
+		// Cone
+		{"cone cone touching apex", cone, Cone{0, 0, 2, math.Pi, math.Pi / 4}, true},
+		{"cone cone overlapping", cone, Cone{3, 0, 2, math.Pi, math.Pi / 4}, true},
+		{"cone cone disjoint", cone, Cone{0, -0.5, 1, -math.Pi / 2, math.Pi / 8}, false},
+		{"cone cone contained", cone, Cone{0.5, 0, 0.5, 0, math.Pi / 8}, true},
+
+		{"cone capsule touching apex", cone, Capsule{-2, 0, -1, 0, 1}, true},
+		{"cone capsule overlapping", cone, Capsule{1, -2, 1, 2, 0.1}, true},
+		{"cone capsule disjoint near edge", cone, Capsule{0.5, -1.5, 1.5, -2.5, 0.2}, false},
+		{"cone capsule contained", cone, Capsule{0.5, 0, 1.2, 0, 0.2}, true},
+
+		// Capsule
+		{"capsule capsule touching", capsule, Capsule{0, 2, 4, 2, 1}, true},
+		{"capsule capsule overlapping", capsule, Capsule{2, -2, 2, 2, 0}, true},
+		{"capsule capsule disjoint", capsule, Capsule{0, 3, 4, 3, 1}, false},
+		{"capsule capsule contained", capsule, Capsule{1, 0, 3, 0, 0.5}, true},
+
+		// Degenerate
+		{"zero length capsule touching", Capsule{1, 1, 1, 1, 0.5}, Circle{2, 1, 0.5}, true},
+		{"zero length capsule disjoint", Capsule{1, 1, 1, 1, 0.5}, Circle{3, 1, 0.5}, false},
+		{"zero length capsule contained", Capsule{1, 1, 1, 1, 0.5}, AABB{0, 0, 2, 2}, true},
+		{"zero length zero radius capsule", Capsule{1, 1, 1, 1, 0}, AABB{0, 0, 2, 2}, true},
+
+		{"zero radius circle inside", Circle{1, 1, 0}, AABB{0, 0, 2, 2}, true},
+		{"zero radius circle on edge", Circle{1, 0, 0}, Circle{0, 0, 1}, true},
+		{"zero radius circle outside", Circle{3, 3, 0}, AABB{0, 0, 2, 2}, false},
+		{"zero radius circles same point", Circle{1, 1, 0}, Circle{1, 1, 0}, true},
+		{"zero radius circles apart", Circle{1, 1, 0}, Circle{1, 2, 0}, false},
+
+		{"zero radius cone at its apex", Cone{0, 0, 0, 0, math.Pi / 4}, Circle{-1, 0, 1}, true},
+		{"zero radius cone near its apex", Cone{0, 0, 0, 0, math.Pi / 4}, Circle{0.5, 0, 0.2}, false},
+
+		{"right angle cone side", Cone{0, 0, 2, 0, math.Pi / 2}, Circle{0, 1.5, 0}, true},
+		{"right angle cone behind", Cone{0, 0, 2, 0, math.Pi / 2}, Circle{-0.1, 1, 0}, false},
+		{"wide cone inside", Cone{0, 0, 2, 0, 3 * math.Pi / 4}, Circle{-0.5, 1, 0}, true},
+		{"wide cone outside", Cone{0, 0, 2, 0, 3 * math.Pi / 4}, Circle{-0.5, 0.4, 0}, false},
+		{"half circle cone is a circle", Cone{0, 0, 2, 0, math.Pi}, Circle{-1, 0, 0}, true},
+		{"over half circle cone is a circle", Cone{0, 0, 2, 0, 4}, Circle{-1.9, 0, 0}, true},
+		{"over half circle cone keeps its radius", Cone{0, 0, 2, 0, 4}, Circle{-2.5, 0, 0}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Intersects(tt.a, tt.b); got != tt.want {
+				t.Errorf("Intersects(a, b) = %v, want %v", got, tt.want)
+			}
+
+			if got := Intersects(tt.b, tt.a); got != tt.want {
+				t.Errorf("Intersects(b, a) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContains(t *testing.T) {
+	tests := []struct {
+		name  string
+		shape Shape
+		x, y  float64
+		want  bool
+	}{
+		{"circle center", Circle{0, 0, 1}, 0, 0, true},
+		{"circle edge", Circle{0, 0, 1}, 0, 1, true},
+		{"circle outside", Circle{0, 0, 1}, 1, 1, false},
+		{"aabb corner", AABB{0, 0, 2, 2}, 2, 2, true},
+		{"aabb outside", AABB{0, 0, 2, 2}, -0.1, 1, false},
+		{"oriented inside", diamond, 1, 0, true},
+		{"oriented corner of its bounds", diamond, 1.3, 1.3, false},
+		{"cone inside", cone, 1.5, 0, true},
+		{"cone behind apex", cone, -0.1, 0, false},
+		{"cone beyond radius", cone, 2.1, 0, false},
+		{"capsule cap", capsule, -1, 0, true},
+		{"capsule outside cap", capsule, -0.8, 0.8, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Contains(tt.shape, tt.x, tt.y); got != tt.want {
+				t.Errorf("Contains(%v, %v) = %v, want %v", tt.x, tt.y, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package shapes
+
+import "math"
+
+// coneSegments is how many segments approximate the arc of a cone
+const coneSegments = 8
+
+// Shape is a hitbox in world coordinates
+type Shape interface {
+	// Bounds returns the axis aligned box around the shape
+	Bounds() AABB
+
+	// hulls splits the shape into convex polygons grown by a radius
+	hulls() []hull
+}
+
+type Point struct {
+	X, Y float64
+}
+
+// hull is a convex polygon of one to n points, grown by the radius
+type hull struct {
+	points []Point
+	radius float64
+}
+
+// Circle is centered at X and Y
+type Circle struct {
+	X, Y   float64
+	Radius float64
+}
+
+// AABB is an axis aligned box, X and Y is the top-left corner
+type AABB struct {
+	X, Y          float64
+	Width, Height float64
+}
+
+// OrientedRect is a box centered at X and Y rotated by Angle radians
+type OrientedRect struct {
+	X, Y          float64
+	Width, Height float64
+	Angle         float64
+}
+
+// Cone is a circle sector from the apex at X and Y, pointing to Angle and
+// opened Spread radians on each side
+type Cone struct {
+	X, Y   float64
+	Radius float64
+	Angle  float64
+	Spread float64
+}
+
+// Capsule is the segment between the two points grown by Radius, a zero
+// radius is a line
+type Capsule struct {
+	X1, Y1 float64
+	X2, Y2 float64
+	Radius float64
+}
+
+func (c Circle) Bounds() AABB {
+	return AABB{
+		X:      c.X - c.Radius,
+		Y:      c.Y - c.Radius,
+		Width:  c.Radius * 2,
+		Height: c.Radius * 2,
+	}
+}
+
+func (c Circle) hulls() []hull {
+	return []hull{{points: []Point{{c.X, c.Y}}, radius: c.Radius}}
+}
+
+func (a AABB) Bounds() AABB {
+	return a
+}
+
+func (a AABB) hulls() []hull {
+	return []hull{{points: []Point{
+		{a.X, a.Y},
+		{a.X + a.Width, a.Y},
+		{a.X + a.Width, a.Y + a.Height},
+		{a.X, a.Y + a.Height},
+	}}}
+}
+
+// Center returns the center of the box
+func (a AABB) Center() (float64, float64) {
+	return a.X + a.Width/2, a.Y + a.Height/2
+}
+
+func (o OrientedRect) Bounds() AABB {
+	return boundsOf(o.corners())
+}
+
+func (o OrientedRect) hulls() []hull {
+	return []hull{{points: o.corners()}}
+}
+
+func (o OrientedRect) corners() []Point {
+	cos, sin := math.Cos(o.Angle), math.Sin(o.Angle)
+	halfWidth, halfHeight := o.Width/2, o.Height/2
+
+	corners := make([]Point, 0, 4)
+
+	for _, c := range [4][2]float64{{-1, -1}, {1, -1}, {1, 1}, {-1, 1}} {
+		x, y := c[0]*halfWidth, c[1]*halfHeight
+
+		corners = append(corners, Point{
+			X: o.X + x*cos - y*sin,
+			Y: o.Y + x*sin + y*cos,
+		})
+	}
+
+	return corners
+}
+
+func (c Cone) Bounds() AABB {
+	var points []Point
+	for _, h := range c.hulls() {
+		points = append(points, h.points...)
+	}
+
+	return boundsOf(points)
+}
+
+// hulls approximates the arc by segments, cones wider than a half circle
+// are split in two convex halves
+func (c Cone) hulls() []hull {
+	spread := math.Min(c.Spread, math.Pi)
+
+	if spread > math.Pi/2 {
+		half := spread / 2
+
+		left := Cone{c.X, c.Y, c.Radius, c.Angle - half, half}
+		right := Cone{c.X, c.Y, c.Radius, c.Angle + half, half}
+
+		return append(left.hulls(), right.hulls()...)
+	}
+
+	points := make([]Point, 0, coneSegments+2)
+	points = append(points, Point{c.X, c.Y})
+
+	for i := 0; i <= coneSegments; i++ {
+		angle := c.Angle - spread + 2*spread*float64(i)/coneSegments
+
+		points = append(points, Point{
+			X: c.X + math.Cos(angle)*c.Radius,
+			Y: c.Y + math.Sin(angle)*c.Radius,
+		})
+	}
+
+	return []hull{{points: points}}
+}
+
+func (c Capsule) Bounds() AABB {
+	return AABB{
+		X:      math.Min(c.X1, c.X2) - c.Radius,
+		Y:      math.Min(c.Y1, c.Y2) - c.Radius,
+		Width:  math.Abs(c.X2-c.X1) + c.Radius*2,
+		Height: math.Abs(c.Y2-c.Y1) + c.Radius*2,
+	}
+}
+
+func (c Capsule) hulls() []hull {
+	return []hull{{points: []Point{{c.X1, c.Y1}, {c.X2, c.Y2}}, radius: c.Radius}}
+}
+
+func boundsOf(points []Point) AABB {
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+
+	for _, p := range points {
+		minX, minY = math.Min(minX, p.X), math.Min(minY, p.Y)
+		maxX, maxY = math.Max(maxX, p.X), math.Max(maxY, p.Y)
+	}
+
+	return AABB{X: minX, Y: minY, Width: maxX - minX, Height: maxY - minY}
+}
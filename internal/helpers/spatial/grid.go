@@ -0,0 +1,196 @@
+package spatial
+
+import (
+	"math"
+	"sort"
+)
+
+// Grid is a uniform grid index over rectangles, items are stored in the cell
+// of their center and queries are widened by the largest item inserted, so
+// an item is found even when it only overlaps a neighbour cell
+type Grid[T any] struct {
+	cellSize float64
+	cells    map[cell][]entry[T]
+
+	// Largest half size inserted since the last Clear
+	maxHalfWidth  float64
+	maxHalfHeight float64
+
+	// Cell bounds of the inserted items, used to stop nearest searches
+	minCell, maxCell cell
+	empty            bool
+}
+
+type cell struct {
+	x, y int
+}
+
+type entry[T any] struct {
+	item                T
+	x, y, width, height float64
+}
+
+func NewGrid[T any](cellSize float64) *Grid[T] {
+	return &Grid[T]{
+		cellSize: cellSize,
+		cells:    map[cell][]entry[T]{},
+		empty:    true,
+	}
+}
+
+// Clear removes every item, cells left empty since the previous Clear are
+// released so the grid doesn't grow while the player walks around
+func (g *Grid[T]) Clear() {
+	for c, entries := range g.cells {
+		if len(entries) == 0 {
+			delete(g.cells, c)
+			continue
+		}
+
+		g.cells[c] = entries[:0]
+	}
+
+	g.maxHalfWidth = 0
+	g.maxHalfHeight = 0
+	g.empty = true
+}
+
+// Insert adds an item with its top-left position and size
+func (g *Grid[T]) Insert(item T, x, y, width, height float64) {
+	c := g.cellAt(x+width/2, y+height/2)
+
+	g.cells[c] = append(g.cells[c], entry[T]{item, x, y, width, height})
+
+	g.maxHalfWidth = math.Max(g.maxHalfWidth, width/2)
+	g.maxHalfHeight = math.Max(g.maxHalfHeight, height/2)
+
+	if g.empty {
+		g.minCell, g.maxCell = c, c
+		g.empty = false
+
+		return
+	}
+
+	g.minCell = cell{min(g.minCell.x, c.x), min(g.minCell.y, c.y)}
+	g.maxCell = cell{max(g.maxCell.x, c.x), max(g.maxCell.y, c.y)}
+}
+
+// QueryRect appends to dst the items overlapping the rectangle
+func (g *Grid[T]) QueryRect(x, y, width, height float64, dst []T) []T {
+	g.visit(x, y, x+width, y+height, func(e *entry[T]) {
+		if e.x < x+width && e.x+e.width > x && e.y < y+height && e.y+e.height > y {
+			dst = append(dst, e.item)
+		}
+	})
+
+	return dst
+}
+
+// QueryRadius appends to dst the items overlapping the circle
+func (g *Grid[T]) QueryRadius(x, y, radius float64, dst []T) []T {
+	g.visit(x-radius, y-radius, x+radius, y+radius, func(e *entry[T]) {
+		dx := x - math.Max(e.x, math.Min(x, e.x+e.width))
+		dy := y - math.Max(e.y, math.Min(y, e.y+e.height))
+
+		if dx*dx+dy*dy <= radius*radius {
+			dst = append(dst, e.item)
+		}
+	})
+
+	return dst
+}
+
+// Nearest returns up to count items accepted by keep, ordered by the distance
+// from their center to the point, the search grows ring by ring until enough
+// items are found, a nil keep accepts every item
+func (g *Grid[T]) Nearest(x, y float64, count int, keep func(T) bool) []T {
+	if g.empty || count <= 0 {
+		return nil
+	}
+
+	type candidate struct {
+		item     T
+		distance float64
+	}
+
+	maxRadius := g.farthestDistance(x, y)
+
+	for radius := g.cellSize; ; radius *= 2 {
+		var candidates []candidate
+
+		g.visit(x-radius, y-radius, x+radius, y+radius, func(e *entry[T]) {
+			dx := e.x + e.width/2 - x
+			dy := e.y + e.height/2 - y
+			distance := math.Sqrt(dx*dx + dy*dy)
+
+			if distance <= radius && (keep == nil || keep(e.item)) {
+				candidates = append(candidates, candidate{e.item, distance})
+			}
+		})
+
+		if len(candidates) < count && radius < maxRadius {
+			continue
+		}
+
+		sort.Slice(candidates, func(i, j int) bool {
+			return candidates[i].distance < candidates[j].distance
+		})
+
+		if len(candidates) > count {
+			candidates = candidates[:count]
+		}
+
+		items := make([]T, len(candidates))
+		for i, c := range candidates {
+			items[i] = c.item
+		}
+
+		return items
+	}
+}
+
+// visit calls fn for every entry stored in the cells touching the area,
+// widened by the largest item
+func (g *Grid[T]) visit(minX, minY, maxX, maxY float64, fn func(e *entry[T])) {
+	if g.empty {
+		return
+	}
+
+	from := g.cellAt(minX-g.maxHalfWidth, minY-g.maxHalfHeight)
+	to := g.cellAt(maxX+g.maxHalfWidth, maxY+g.maxHalfHeight)
+
+	// Never look outside the cells that have items
+	from = cell{max(from.x, g.minCell.x), max(from.y, g.minCell.y)}
+	to = cell{min(to.x, g.maxCell.x), min(to.y, g.maxCell.y)}
+
+	for cx := from.x; cx <= to.x; cx++ {
+		for cy := from.y; cy <= to.y; cy++ {
+			entries := g.cells[cell{cx, cy}]
+
+			for i := range entries {
+				fn(&entries[i])
+			}
+		}
+	}
+}
+
+// farthestDistance is the distance from the point to the farthest corner of
+// the occupied cells
+func (g *Grid[T]) farthestDistance(x, y float64) float64 {
+	minX := float64(g.minCell.x) * g.cellSize
+	minY := float64(g.minCell.y) * g.cellSize
+	maxX := float64(g.maxCell.x+1) * g.cellSize
+	maxY := float64(g.maxCell.y+1) * g.cellSize
+
+	dx := math.Max(math.Abs(x-minX), math.Abs(x-maxX))
+	dy := math.Max(math.Abs(y-minY), math.Abs(y-maxY))
+
+	return math.Sqrt(dx*dx+dy*dy) + g.cellSize
+}
+
+func (g *Grid[T]) cellAt(x, y float64) cell {
+	return cell{
+		int(math.Floor(x / g.cellSize)),
+		int(math.Floor(y / g.cellSize)),
+	}
+}
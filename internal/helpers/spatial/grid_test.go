@@ -0,0 +1,312 @@
+package spatial
+
+import (
+	"fmt"
+	"game/internal/helpers/spatial/spatialtest"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+)
+
+// cellSize is the one used by the enemy plugin
+const cellSize = 64
+
+type item struct {
+	id                  int
+	x, y, width, height float64
+}
+
+func scatter(r *rand.Rand, count int, area float64) []*item {
+	items := make([]*item, count)
+
+	for i, box := range spatialtest.Scatter(r, count, area, 8, 72) {
+		items[i] = &item{id: i, x: box.X, y: box.Y, width: box.Width, height: box.Height}
+	}
+
+	return items
+}
+
+// boundaries returns items laid exactly on cell edges and across them, on
+// both sides of the origin
+func boundaries() []*item {
+	var items []*item
+
+	for _, x := range []float64{-2 * cellSize, -cellSize, -cellSize / 2, 0, cellSize, 2 * cellSize} {
+		for _, y := range []float64{-cellSize, -0.5, 0, cellSize - 0.5, cellSize} {
+			for _, size := range []float64{0, 1, cellSize, cellSize * 1.5} {
+				items = append(items, &item{id: len(items), x: x, y: y, width: size, height: size})
+			}
+		}
+	}
+
+	return items
+}
+
+func build(items []*item) *Grid[*item] {
+	grid := NewGrid[*item](cellSize)
+
+	for _, it := range items {
+		grid.Insert(it, it.x, it.y, it.width, it.height)
+	}
+
+	return grid
+}
+
+func bruteRect(items []*item, x, y, width, height float64) []*item {
+	var result []*item
+
+	for _, it := range items {
+		if it.x < x+width && it.x+it.width > x && it.y < y+height && it.y+it.height > y {
+			result = append(result, it)
+		}
+	}
+
+	return result
+}
+
+func bruteRadius(items []*item, x, y, radius float64) []*item {
+	var result []*item
+
+	for _, it := range items {
+		dx := x - math.Max(it.x, math.Min(x, it.x+it.width))
+		dy := y - math.Max(it.y, math.Min(y, it.y+it.height))
+
+		if dx*dx+dy*dy <= radius*radius {
+			result = append(result, it)
+		}
+	}
+
+	return result
+}
+
+func centerDistance(it *item, x, y float64) float64 {
+	return math.Hypot(it.x+it.width/2-x, it.y+it.height/2-y)
+}
+
+func TestQueryRect(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	for _, items := range [][]*item{scatter(r, 500, 1000), boundaries()} {
+		grid := build(items)
+
+		queries := [][4]float64{
+			{0, 0, cellSize, cellSize},
+			{-cellSize, -cellSize, cellSize, cellSize},
+			{-cellSize / 2, -cellSize / 2, cellSize, cellSize},
+			{cellSize, 0, 0, 0},
+			{-1000, -1000, 2000, 2000},
+		}
+
+		for i := 0; i < 200; i++ {
+			queries = append(queries, [4]float64{
+				(r.Float64() - 0.5) * 1200,
+				(r.Float64() - 0.5) * 1200,
+				r.Float64() * 300,
+				r.Float64() * 300,
+			})
+		}
+
+		for _, q := range queries {
+			got := grid.QueryRect(q[0], q[1], q[2], q[3], nil)
+			want := bruteRect(items, q[0], q[1], q[2], q[3])
+
+			if !spatialtest.Same(got, want) {
+				t.Fatalf("QueryRect(%v) returned %v items, want %v", q, len(got), len(want))
+			}
+		}
+	}
+}
+
+func TestQueryRadius(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+
+	for _, items := range [][]*item{scatter(r, 500, 1000), boundaries()} {
+		grid := build(items)
+
+		queries := [][3]float64{
+			{0, 0, cellSize},
+			{-cellSize, -cellSize, 0},
+			{cellSize, cellSize, cellSize / 2},
+			{-0.5, -0.5, 1},
+			{0, 0, 2000},
+		}
+
+		for i := 0; i < 200; i++ {
+			queries = append(queries, [3]float64{
+				(r.Float64() - 0.5) * 1200,
+				(r.Float64() - 0.5) * 1200,
+				r.Float64() * 300,
+			})
+		}
+
+		for _, q := range queries {
+			got := grid.QueryRadius(q[0], q[1], q[2], nil)
+			want := bruteRadius(items, q[0], q[1], q[2])
+
+			if !spatialtest.Same(got, want) {
+				t.Fatalf("QueryRadius(%v) returned %v items, want %v", q, len(got), len(want))
+			}
+		}
+	}
+}
+
+func TestNearest(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 6))
+
+	even := func(it *item) bool { return it.id%2 == 0 }
+
+	for _, items := range [][]*item{scatter(r, 500, 1000), boundaries()} {
+		grid := build(items)
+
+		for i := 0; i < 200; i++ {
+			x := (r.Float64() - 0.5) * 3000
+			y := (r.Float64() - 0.5) * 3000
+			count := 1 + r.IntN(20)
+
+			for _, keep := range []func(*item) bool{nil, even} {
+				var kept []*item
+				for _, it := range items {
+					if keep == nil || keep(it) {
+						kept = append(kept, it)
+					}
+				}
+
+				sort.Slice(kept, func(i, j int) bool {
+					return centerDistance(kept[i], x, y) < centerDistance(kept[j], x, y)
+				})
+
+				want := kept[:min(count, len(kept))]
+				got := grid.Nearest(x, y, count, keep)
+
+				if len(got) != len(want) {
+					t.Fatalf("Nearest(%v, %v, %v) returned %v items, want %v", x, y, count, len(got), len(want))
+				}
+
+				// Items at the same distance may come in any order
+				for j := range got {
+					if keep != nil && !keep(got[j]) {
+						t.Fatalf("Nearest(%v, %v, %v) returned item %v rejected by keep", x, y, count, got[j].id)
+					}
+
+					if centerDistance(got[j], x, y) != centerDistance(want[j], x, y) {
+						t.Fatalf("Nearest(%v, %v, %v)[%v] = %v, want %v", x, y, count, j, got[j].id, want[j].id)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestClear(t *testing.T) {
+	grid := build(boundaries())
+	grid.Clear()
+
+	if got := grid.QueryRect(-1000, -1000, 2000, 2000, nil); len(got) != 0 {
+		t.Fatalf("QueryRect after Clear returned %v items", len(got))
+	}
+
+	if got := grid.Nearest(0, 0, 1, nil); got != nil {
+		t.Fatalf("Nearest after Clear returned %v items", len(got))
+	}
+
+	// Only the cells kept empty since the previous Clear are released
+	grid.Clear()
+
+	if len(grid.cells) != 0 {
+		t.Fatalf("grid kept %v empty cells", len(grid.cells))
+	}
+
+	it := &item{x: -cellSize, y: -cellSize, width: 10, height: 10}
+	grid.Insert(it, it.x, it.y, it.width, it.height)
+
+	if got := grid.QueryRadius(-cellSize, -cellSize, 1, nil); len(got) != 1 || got[0] != it {
+		t.Fatalf("QueryRadius after reuse = %v, want the inserted item", got)
+	}
+}
+
+func BenchmarkRebuild(b *testing.B) {
+	for _, count := range spatialtest.Crowds {
+		b.Run(fmt.Sprint(count), func(b *testing.B) {
+			items := scatter(rand.New(rand.NewPCG(1, 2)), count, spatialtest.Area)
+			grid := NewGrid[*item](cellSize)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				grid.Clear()
+
+				for _, it := range items {
+					grid.Insert(it, it.x, it.y, it.width, it.height)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkQueryRadius(b *testing.B) {
+	for _, count := range spatialtest.Crowds {
+		b.Run(fmt.Sprint(count), func(b *testing.B) {
+			r := rand.New(rand.NewPCG(1, 2))
+			items := scatter(r, count, spatialtest.Area)
+			grid := build(items)
+
+			var dst []*item
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				it := items[i%len(items)]
+				dst = grid.QueryRadius(it.x, it.y, 100, dst[:0])
+			}
+		})
+	}
+}
+
+func BenchmarkNearest(b *testing.B) {
+	for _, count := range spatialtest.Crowds {
+		b.Run(fmt.Sprint(count), func(b *testing.B) {
+			r := rand.New(rand.NewPCG(1, 2))
+			grid := build(scatter(r, count, spatialtest.Area))
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				grid.Nearest((r.Float64()-0.5)*spatialtest.Area, (r.Float64()-0.5)*spatialtest.Area, 5, nil)
+			}
+		})
+	}
+}
+
+// BenchmarkFrame rebuilds the grid and runs a query around every item, the
+// worst case of a crowd of swarming enemies, ns/op is the share of the
+// 16.6ms frame at 60 TPS. The 400 enemies of the cap take a small part of it
+// and a few thousand still fit, ten thousand go over the frame
+func BenchmarkFrame(b *testing.B) {
+	for _, count := range spatialtest.Crowds {
+		b.Run(fmt.Sprint(count), func(b *testing.B) {
+			items := scatter(rand.New(rand.NewPCG(1, 2)), count, spatialtest.Area)
+			grid := NewGrid[*item](cellSize)
+
+			var dst []*item
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				grid.Clear()
+
+				for _, it := range items {
+					grid.Insert(it, it.x, it.y, it.width, it.height)
+				}
+
+				for _, it := range items {
+					dst = grid.QueryRadius(it.x+it.width/2, it.y+it.height/2, it.width, dst[:0])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Package spatialtest builds the crowds the spatial queries are tested and
+// benchmarked on
+package spatialtest
+
+import "math/rand/v2"
+
+// Crowds are the crowd sizes of the benchmarks, from the enemy cap of a run
+// to the stress cases past it
+var Crowds = []int{400, 2000, 5000, 10000}
+
+// Area is the side of the square the benchmark crowds are spread on, a few
+// screens around the player
+const Area = 3000
+
+// Box is the top-left position and size of an item
+type Box struct {
+	X, Y          float64
+	Width, Height float64
+}
+
+// Scatter returns count square boxes sized between minSize and maxSize,
+// spread on a square of the side centered on the origin, so half of them
+// are at negative coordinates
+func Scatter(r *rand.Rand, count int, side, minSize, maxSize float64) []Box {
+	boxes := make([]Box, count)
+
+	for i := range boxes {
+		size := minSize + r.Float64()*(maxSize-minSize)
+
+		boxes[i] = Box{
+			X:      (r.Float64() - 0.5) * side,
+			Y:      (r.Float64() - 0.5) * side,
+			Width:  size,
+			Height: size,
+		}
+	}
+
+	return boxes
+}
+
+// Same tells whether the two slices hold the same items in any order
+func Same[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	counts := make(map[T]int, len(a))
+	for _, item := range a {
+		counts[item]++
+	}
+
+	for _, item := range b {
+		if counts[item] == 0 {
+			return false
+		}
+
+		counts[item]--
+	}
+
+	return true
+}
@@ -2,7 +2,11 @@ package plugins
 
 import (
 	"game/internal/core"
+	"game/internal/helpers/shapes"
+	"game/internal/plugins/playing/damage"
 	"game/internal/plugins/playing/enemy/entities"
+	"game/internal/plugins/playing/player/attributes"
+	playerentities "game/internal/plugins/playing/player/entities"
 
 	"github.com/hajimehoshi/ebiten/v2"
 )
@@ -14,8 +18,16 @@ type EnemyPlugin interface {
 	Draw(screen *ebiten.Image)
 	Spawn()
 	GetEnemies() []*entities.Enemy
-	ApplyDamage(enemy *entities.Enemy, damage float64, isCriticalDamage bool)
-	GetGlobalProjectiles() []*entities.Projectile
+	GetBosses() []*entities.Enemy
+	EnemiesInRadius(x, y, radius float64) []*entities.Enemy
+	EnemiesInRect(x, y, width, height float64) []*entities.Enemy
+	NearestEnemies(x, y float64, count int) []*entities.Enemy
+	ApplyDamage(enemy *entities.Enemy, amount float64, isCriticalDamage bool, damageType damage.Type)
+}
+
+type HostileProjectilePlugin interface {
+	ID() string
+	Fire(p *entities.Projectile)
 }
 
 type PlayerPlugin interface {
@@ -27,6 +39,7 @@ type PlayerPlugin interface {
 	DecreaseHealth(float64)
 
 	GetSize() (float64, float64)
+	GetHitbox() shapes.Shape
 	GetLevel() float64
 	GetExperience() float64
 
@@ -38,15 +51,28 @@ type PlayerPlugin interface {
 	GetSpeed() float64
 	GetHealthRegenRate() float64
 	GetHealthRegenDelay() float64
-	GetAdditionalDamagePercent() float64
 	GetCollectionRadius() float64
 
+	GetAttribute(a attributes.Attribute) float64
+	GetAttributes() *attributes.Set
+	AddModifier(m attributes.Modifier)
+	RemoveModifiers(source string)
+
 	NextLevelPercentage() float64
 
 	ApplyDamage(damage float64)
-	CalculateDamage(baseDamage float64) (float64, bool)
+	Heal(amount float64)
+	Shockwave()
+	Hit(hit playerentities.Hit) (float64, bool)
+	AddRevives(amount int)
+	GetRevives() int
+	IsInvulnerable() bool
+	CalculateDamage(baseDamage float64) float64
 
-	GetDashTimer() float64
+	IsDashing() bool
+	GetDashCharges() (int, int)
+	GetDashRechargeProgress() float64
+	UpgradeDash(upgrade playerentities.DashUpgrade)
 
 	GetNextLevelExperience() int
 
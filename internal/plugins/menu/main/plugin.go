@@ -10,11 +10,13 @@ import (
 	"image/color"
 	"log"
 
+	"game/internal/plugins/playing/combatlog"
 	playerentities "game/internal/plugins/playing/player/entities"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/text"
 	"github.com/hajimehoshi/ebiten/v2/vector"
+	"golang.org/x/image/font/basicfont"
 )
 
 type MenuPlugin struct {
@@ -29,6 +31,9 @@ type MenuPlugin struct {
 
 	initialMenuAnimation *assets.Animation
 	backgroundAnimation  *assets.Animation
+
+	// summary of the last run, shown on the game over screen
+	summary *combatlog.Summary
 }
 
 func NewMenuPlugin(kernel *core.GameKernel) *MenuPlugin {
@@ -72,6 +77,13 @@ func (m *MenuPlugin) Init(kernel *core.GameKernel) error {
 	return nil
 }
 
+// ShowSummary switches to the game over screen with the run statistics
+func (m *MenuPlugin) ShowSummary(summary combatlog.Summary) {
+	m.summary = &summary
+	m.currentState = menu.GameOverState
+	m.canTransition = false
+}
+
 func (m *MenuPlugin) Update() error {
 	if !m.canTransition && !ebiten.IsKeyPressed(ebiten.KeyEnter) {
 		m.canTransition = true
@@ -186,5 +198,34 @@ func (m *MenuPlugin) Draw(screen *ebiten.Image) {
 		text.Draw(screen, "Game Over", fontface.FontFace, 350, 200, color.White)
 		text.Draw(screen, "Press ENTER to Restart", fontface.FontFace, 300, 250, color.White)
 
+		if m.summary != nil {
+			m.drawSummary(screen)
+		}
+
+	}
+}
+
+// drawSummary lists the damage dealt by every ability and taken from every
+// enemy during the last run
+func (m *MenuPlugin) drawSummary(screen *ebiten.Image) {
+	y := 300
+	line := func(s string, c color.Color) {
+		text.Draw(screen, s, basicfont.Face7x13, 200, y, c)
+		y += 16
+	}
+
+	line("Survived "+combatlog.FormatDuration(m.summary.Duration), color.White)
+	y += 8
+
+	line("Damage dealt", color.RGBA{255, 215, 0, 255})
+	for _, stats := range m.summary.Abilities {
+		line(stats.String(), color.White)
+	}
+
+	y += 8
+
+	line("Damage taken", color.RGBA{255, 80, 80, 255})
+	for _, stats := range m.summary.Taken {
+		line(stats.String(), color.White)
 	}
 }
@@ -0,0 +1,46 @@
+package abilities
+
+import (
+	"game/internal/core"
+	"game/internal/plugins"
+	"game/internal/plugins/playing/player/attributes"
+	"math"
+)
+
+const maxCooldownReduction = 80.0
+
+// PlayerAttribute reads a player attribute, 0 when the ability is not
+// attached to a running game yet
+func PlayerAttribute(pm *core.PluginManager, a attributes.Attribute) float64 {
+	if pm == nil {
+		return 0
+	}
+
+	playerPlugin, ok := pm.GetPlugin("PlayerSystem").(plugins.PlayerPlugin)
+	if !ok {
+		return 0
+	}
+
+	return playerPlugin.GetAttribute(a)
+}
+
+// Cooldown applies the player cooldown reduction to an ability cooldown
+func Cooldown(pm *core.PluginManager, cooldown float64) float64 {
+	reduction := math.Min(PlayerAttribute(pm, attributes.CooldownReduction), maxCooldownReduction)
+
+	return cooldown * (1 - reduction/100)
+}
+
+// ProjectileCount adds the player extra projectiles to an ability count
+func ProjectileCount(pm *core.PluginManager, count int) int {
+	return count + int(PlayerAttribute(pm, attributes.ProjectileCount))
+}
+
+// Area scales an ability size by the player area multiplier
+func Area(pm *core.PluginManager, size float64) float64 {
+	if pm == nil {
+		return size
+	}
+
+	return size * PlayerAttribute(pm, attributes.Area)
+}
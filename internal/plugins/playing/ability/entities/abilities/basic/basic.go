@@ -2,41 +2,23 @@ package basic
 
 import (
 	"game/internal/assets"
-	"game/internal/constants"
 	"game/internal/core"
-	"game/internal/helpers/collision"
 	"game/internal/plugins"
 	abilityentities "game/internal/plugins/playing/ability/entities/abilities"
-	"game/internal/plugins/playing/camera"
+	"game/internal/plugins/playing/ability/entities/abilities/projectile"
+	"game/internal/plugins/playing/ability/levels"
+	"game/internal/plugins/playing/combat/events"
+	"game/internal/plugins/playing/damage"
 	"image/color"
 	"log"
 	"math"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/vector"
 )
 
-type Projectile struct {
-	Active bool
-	Power  float64
-
-	X, Y       float64
-	Speed      float64
-	DirectionX float64
-	DirectionY float64
-
-	TargetX float64
-	TargetY float64
-
-	Width  float64
-	Height float64
-
-	Animation *assets.Animation
-}
-
 type Basic struct {
 	plugins     *core.PluginManager
-	Projectiles []*Projectile
+	projectiles *projectile.Manager
 	Power       float64
 
 	// Shoot cooldown
@@ -45,6 +27,9 @@ type Basic struct {
 
 	Level int
 
+	// Projectiles stop at trees and rocks
+	BlockedByTerrain bool
+
 	BaseAnimation *assets.Animation
 }
 
@@ -60,15 +45,18 @@ func New() *Basic {
 	}
 
 	return &Basic{
-		Power:         10,
-		ShootCooldown: 1.0,
-		Level:         1,
-		BaseAnimation: BaseAnimation,
+		Power:            10,
+		ShootCooldown:    1.0,
+		Level:            1,
+		BlockedByTerrain: true,
+		BaseAnimation:    BaseAnimation,
+		projectiles:      projectile.NewManager(),
 	}
 }
 
 func (b *Basic) SetPluginManager(plugins *core.PluginManager) {
 	b.plugins = plugins
+	b.projectiles.SetPluginManager(plugins)
 }
 
 func (b *Basic) ID() string {
@@ -78,7 +66,7 @@ func (b *Basic) ID() string {
 func (b *Basic) AutoShot(deltaTime, x, y float64) {
 	b.ShootTimer += deltaTime
 
-	if b.ShootTimer >= b.ShootCooldown {
+	if b.ShootTimer >= abilityentities.Cooldown(b.plugins, b.ShootCooldown) {
 		b.Shoot(x, y)
 		b.ShootTimer = 0
 	}
@@ -88,26 +76,10 @@ func (b *Basic) Shoot(x, y float64) {
 	// Get enemy plugin to find closest enemy
 	enemyPlugin := b.plugins.GetPlugin("EnemySystem").(plugins.EnemyPlugin)
 
-	enemies := enemyPlugin.GetEnemies()
-
-	if len(enemies) > 0 {
-		closestEnemy := enemies[0]
-		closestDist := math.MaxFloat64
-
-		for _, enemy := range enemies {
-			if !enemy.Active {
-				continue
-			}
-
-			dx := (enemy.X + enemy.Width/2) - x
-			dy := (enemy.Y + enemy.Height/2) - y
-			dist := math.Sqrt(dx*dx + dy*dy)
+	nearest := enemyPlugin.NearestEnemies(x, y, 1)
 
-			if dist < closestDist {
-				closestDist = dist
-				closestEnemy = enemy
-			}
-		}
+	if len(nearest) > 0 {
+		closestEnemy := nearest[0]
 
 		// Calcular direção
 		dx := (closestEnemy.X + closestEnemy.Width/2) - x
@@ -115,100 +87,49 @@ func (b *Basic) Shoot(x, y float64) {
 
 		distance := math.Sqrt(dx*dx + dy*dy)
 
-		dirX := dx / distance
-		dirY := dy / distance
-
-		// Create bullet targeting closest enemy
-		projectile := &Projectile{
-			X:          x,
-			Y:          y,
-			Speed:      300,
-			Active:     true,
-			Power:      b.Power,
-			TargetX:    closestEnemy.X + closestEnemy.Width/2,
-			TargetY:    closestEnemy.Y + closestEnemy.Height/2,
-			DirectionX: dirX,
-			DirectionY: dirY,
-			Height:     10,
-			Width:      10,
-			Animation:  b.BaseAnimation,
+		angle := math.Atan2(dy/distance, dx/distance)
+
+		// Extra projectiles fan out around the closest enemy
+		count := abilityentities.ProjectileCount(b.plugins, 1)
+
+		for i := 0; i < count; i++ {
+			spread := (float64(i) - float64(count-1)/2) * 0.15
+
+			// Create bullet targeting closest enemy
+			b.projectiles.Spawn(projectile.Projectile{
+				X:                x - 5,
+				Y:                y - 5,
+				Speed:            300,
+				Power:            b.Power,
+				DirectionX:       math.Cos(angle + spread),
+				DirectionY:       math.Sin(angle + spread),
+				Height:           10,
+				Width:            10,
+				Range:            800,
+				BlockedByTerrain: b.BlockedByTerrain,
+				Color:            color.RGBA{200, 255, 0, 255},
+				Mechanics:        levels.Mechanics(b.ID(), b.Level),
+			})
 		}
-
-		b.Projectiles = append(b.Projectiles, projectile)
 	}
 }
 
 func (b *Basic) Update(wui abilityentities.AbilityUpdateInput) {
 	b.AutoShot(wui.DeltaTime, wui.PlayerX, wui.PlayerY)
 
-	for _, projectile := range b.Projectiles {
-		if projectile.Active {
-			dx := projectile.DirectionX
-			dy := projectile.DirectionY
-
-			// Calculate distance
-			distance := math.Sqrt(dx*dx + dy*dy)
-
-			if distance > 0 {
-				// Normalize direction
-				dx /= distance
-				dy /= distance
-
-				// Update position
-				projectile.X += dx * projectile.Speed * wui.DeltaTime
-				projectile.Y += dy * projectile.Speed * wui.DeltaTime
-			}
-
-			cameraPlugin := b.plugins.GetPlugin("CameraSystem").(*camera.CameraPlugin)
-			cameraX, cameraY := cameraPlugin.GetPosition()
-			// Check if projectile is too far from camera view
-			screenX := projectile.X - cameraX
-			screenY := projectile.Y - cameraY
-			margin := float64(200) // Larger margin before deactivating
-
-			if screenX < -margin ||
-				screenX > constants.ScreenWidth+margin ||
-				screenY < -margin ||
-				screenY > constants.ScreenHeight+margin {
-
-				projectile.Active = false
-			}
-		}
-	}
+	b.projectiles.Update(wui)
 }
 
 func (b *Basic) Draw(screen *ebiten.Image, wdi abilityentities.AbilityDrawInput) {
-	for _, projectile := range b.Projectiles {
-		if projectile.Active {
-			// Draw bullet relative to camera position
-			screenX := projectile.X - wdi.CameraX
-			screenY := projectile.Y - wdi.CameraY
-
-			// Only draw if on screen
-			if screenX >= -5 && screenX <= constants.ScreenWidth+5 &&
-				screenY >= -5 && screenY <= constants.ScreenHeight+5 {
-
-				vector.DrawFilledRect(
-					screen,
-					float32(screenX),
-					float32(screenY),
-					float32(projectile.Width),
-					float32(projectile.Height),
-					color.RGBA{200, 255, 0, 255},
-					true)
-			}
-		}
-	}
-
-	return
+	b.projectiles.Draw(screen, wdi)
 }
 
 func (b *Basic) GetPower() float64 {
 	return b.Power
 }
 
-func (*Basic) DamageType() string {
-	return "projectil"
+func (*Basic) DamageType() damage.Type {
+	return damage.Physical
 }
 
 func (*Basic) AttackSpeed() float64 {
@@ -233,38 +154,10 @@ func (b *Basic) IncreaseLevel() {
 	b.ShootCooldown -= 0.1
 }
 
-func (b *Basic) Combat(ci abilityentities.CombatInput) abilityentities.CombatOutput {
-	enemy := ci.Enemy
-	pp := ci.PlayerPlugin
-	enemyGotDamaged := false
-	damage := 0.0
-	critical := false
-
-	for _, projectil := range b.Projectiles {
-		if enemy.Active && projectil.Active {
-			checkSpriteCollisionInput := collision.CheckSpriteCollisionInput{
-				X1:      projectil.X,
-				Y1:      projectil.Y,
-				Width1:  projectil.Width,
-				Height1: projectil.Height,
-				X2:      enemy.X,
-				Y2:      enemy.Y,
-				Width2:  enemy.Width,
-				Height2: enemy.Height,
-			}
-
-			if collision.CheckSpriteCollision(checkSpriteCollisionInput) {
-				damage, critical = pp.CalculateDamage(projectil.Power)
-
-				projectil.Active = false
-				enemyGotDamaged = true
-			}
-		}
-	}
+func (b *Basic) Colliders() []abilityentities.Collider {
+	return b.projectiles.Colliders(func(p *projectile.Projectile, ci abilityentities.CombatInput) events.DamageEvent {
+		centerX, centerY := p.Center()
 
-	return abilityentities.CombatOutput{
-		EnemyGotDamaged: enemyGotDamaged,
-		Damage:          damage,
-		CriticalDamage:  critical,
-	}
+		return abilityentities.Hit(ci, b, p.Power, centerX, centerY)
+	})
 }
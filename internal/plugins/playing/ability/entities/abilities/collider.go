@@ -0,0 +1,15 @@
+package abilities
+
+import (
+	"game/internal/helpers/shapes"
+	"game/internal/plugins/playing/combat/events"
+)
+
+// Collider is an area of an ability able to hit enemies, the combat plugin
+// only calls Hit with the enemies whose hitbox intersects the shape
+type Collider struct {
+	Shape shapes.Shape
+
+	// Hit returns the hit on the input enemy, if any
+	Hit func(ci CombatInput) (events.DamageEvent, bool)
+}
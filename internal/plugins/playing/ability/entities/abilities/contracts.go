@@ -3,6 +3,7 @@ package abilities
 import (
 	"game/internal/core"
 	"game/internal/plugins"
+	"game/internal/plugins/playing/damage"
 	enemyentities "game/internal/plugins/playing/enemy/entities"
 
 	"github.com/hajimehoshi/ebiten/v2"
@@ -38,12 +39,6 @@ type CombatInput struct {
 	Enemy *enemyentities.Enemy
 }
 
-type CombatOutput struct {
-	Damage          float64
-	CriticalDamage  bool
-	EnemyGotDamaged bool
-}
-
 type Ability interface {
 	ID() string
 	SetPluginManager(plugins *core.PluginManager)
@@ -53,9 +48,10 @@ type Ability interface {
 
 	GetPower() float64
 
-	DamageType() string
+	DamageType() damage.Type
 
-	Combat(ci CombatInput) CombatOutput
+	// Colliders returns the current hit areas of the ability
+	Colliders() []Collider
 
 	AttackSpeed() float64
 
@@ -1,40 +1,24 @@
 package dagger
 
 import (
-	"game/internal/constants"
 	"game/internal/core"
-	"game/internal/helpers/collision"
 	abilityentities "game/internal/plugins/playing/ability/entities/abilities"
 	entityabilities "game/internal/plugins/playing/ability/entities/abilities"
-	"game/internal/plugins/playing/camera"
+	"game/internal/plugins/playing/ability/entities/abilities/projectile"
+	"game/internal/plugins/playing/ability/levels"
+	"game/internal/plugins/playing/combat/events"
+	"game/internal/plugins/playing/damage"
 
 	"image/color"
 	"math"
 	"math/rand/v2"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/vector"
 )
 
-type Projectile struct {
-	Active bool
-	Power  float64
-
-	X, Y       float64
-	Speed      float64
-	DirectionX float64
-	DirectionY float64
-
-	TargetX float64
-	TargetY float64
-
-	Width  float64
-	Height float64
-}
-
 type Dagger struct {
 	plugins            *core.PluginManager
-	Projectiles        []*Projectile
+	projectiles        *projectile.Manager
 	Power              float64
 	ProjectilesByShoot int
 
@@ -43,6 +27,9 @@ type Dagger struct {
 	ShootCooldown float64
 
 	Level int
+
+	// Projectiles stop at trees and rocks
+	BlockedByTerrain bool
 }
 
 func New() *Dagger {
@@ -51,11 +38,14 @@ func New() *Dagger {
 		ShootCooldown:      2.3,
 		Level:              1,
 		ProjectilesByShoot: 5,
+		BlockedByTerrain:   true,
+		projectiles:        projectile.NewManager(),
 	}
 }
 
 func (d *Dagger) SetPluginManager(plugins *core.PluginManager) {
 	d.plugins = plugins
+	d.projectiles.SetPluginManager(plugins)
 }
 
 func (d *Dagger) ID() string {
@@ -65,89 +55,55 @@ func (d *Dagger) ID() string {
 func (d *Dagger) AutoShot(deltaTime, x, y float64) {
 	d.ShootTimer += deltaTime
 
-	if d.ShootTimer >= d.ShootCooldown {
+	if d.ShootTimer >= abilityentities.Cooldown(d.plugins, d.ShootCooldown) {
 		d.Shoot(x, y)
 		d.ShootTimer = 0
 	}
 }
 
 func (d *Dagger) Shoot(x, y float64) {
-	for i := 0; i < d.ProjectilesByShoot; i++ {
+	count := abilityentities.ProjectileCount(d.plugins, d.ProjectilesByShoot)
+
+	for i := 0; i < count; i++ {
 		angle := rand.Float64() * 2 * math.Pi
 		directionX := math.Cos(angle)
 		directionY := math.Sin(angle)
 
-		projectile := &Projectile{
-			X:          x,
-			Y:          y,
-			Speed:      300,
-			DirectionX: directionX,
-			DirectionY: directionY,
-			Active:     true,
-			Power:      d.Power,
-			Height:     5,
-			Width:      5,
-		}
-
-		d.Projectiles = append(d.Projectiles, projectile)
+		d.projectiles.Spawn(projectile.Projectile{
+			X:                x - 2.5,
+			Y:                y - 2.5,
+			Speed:            300,
+			DirectionX:       directionX,
+			DirectionY:       directionY,
+			Power:            d.Power,
+			Height:           5,
+			Width:            5,
+			Movement:         projectile.Boomerang,
+			ReturnAfter:      200,
+			Lifetime:         3,
+			BlockedByTerrain: d.BlockedByTerrain,
+			Color:            color.RGBA{255, 255, 0, 255},
+			Mechanics:        levels.Mechanics(d.ID(), d.Level),
+		})
 	}
 }
 
 func (d *Dagger) Update(wui entityabilities.AbilityUpdateInput) {
-	deltatime := wui.DeltaTime
-	d.AutoShot(deltatime, wui.PlayerX, wui.PlayerY)
-
-	for _, p := range d.Projectiles {
-		if !p.Active {
-			continue
-		}
-
-		p.X += p.DirectionX * p.Speed * deltatime
-		p.Y += p.DirectionY * p.Speed * deltatime
+	d.AutoShot(wui.DeltaTime, wui.PlayerX, wui.PlayerY)
 
-		cameraPlugin := d.plugins.GetPlugin("CameraSystem").(*camera.CameraPlugin)
-		cameraX, cameraY := cameraPlugin.GetPosition()
-
-		screenX := p.X - cameraX
-		screenY := p.Y - cameraY
-		margin := float64(200)
-
-		if screenX < -margin ||
-			screenX > constants.ScreenWidth+margin ||
-			screenY < -margin ||
-			screenY > constants.ScreenHeight+margin {
-
-			p.Active = false
-		}
-	}
+	d.projectiles.Update(wui)
 }
 
 func (d *Dagger) Draw(screen *ebiten.Image, wdi entityabilities.AbilityDrawInput) {
-	for _, p := range d.Projectiles {
-		if p.Active {
-			screenX := p.X - wdi.CameraX
-			screenY := p.Y - wdi.CameraY
-
-			vector.DrawFilledRect(
-				screen,
-				float32(screenX),
-				float32(screenY),
-				float32(p.Width),
-				float32(p.Height),
-				color.RGBA{255, 255, 0, 255},
-				true)
-		}
-	}
-
-	return
+	d.projectiles.Draw(screen, wdi)
 }
 
 func (d *Dagger) GetPower() float64 {
 	return d.Power
 }
 
-func (d *Dagger) DamageType() string {
-	return "projectil"
+func (d *Dagger) DamageType() damage.Type {
+	return damage.Physical
 }
 
 func (*Dagger) AttackSpeed() float64 {
@@ -172,36 +128,10 @@ func (d *Dagger) IncreaseLevel() {
 	d.ProjectilesByShoot++
 }
 
-func (d *Dagger) Combat(ci abilityentities.CombatInput) abilityentities.CombatOutput {
-	enemy := ci.Enemy
-	pp := ci.PlayerPlugin
-	enemyGotDamaged := false
-	damage := 0.0
-	critical := false
-
-	for _, projectil := range d.Projectiles {
-		if enemy.Active && projectil.Active {
-			if collision.Check(
-				projectil.X,
-				projectil.Y,
-				projectil.Width,
-				projectil.Height,
-				enemy.X,
-				enemy.Y,
-				enemy.Width,
-				enemy.Height) {
-
-				damage, critical = pp.CalculateDamage(projectil.Power)
-
-				projectil.Active = false
-				enemyGotDamaged = true
-			}
-		}
-	}
+func (d *Dagger) Colliders() []abilityentities.Collider {
+	return d.projectiles.Colliders(func(p *projectile.Projectile, ci abilityentities.CombatInput) events.DamageEvent {
+		centerX, centerY := p.Center()
 
-	return abilityentities.CombatOutput{
-		EnemyGotDamaged: enemyGotDamaged,
-		Damage:          damage,
-		CriticalDamage:  critical,
-	}
+		return abilityentities.Hit(ci, d, p.Power, centerX, centerY)
+	})
 }
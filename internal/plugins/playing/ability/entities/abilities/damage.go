@@ -0,0 +1,19 @@
+package abilities
+
+import (
+	"game/internal/plugins/playing/combat/events"
+)
+
+// Hit builds the damage event of the ability hitting the combat enemy from
+// x, y, boosted by the player damage, the combat pipeline rolls the critical
+// hits and mitigates it by the enemy defenses
+func Hit(ci CombatInput, a Ability, power, x, y float64) events.DamageEvent {
+	return events.DamageEvent{
+		Source: a.ID(),
+		Target: ci.Enemy,
+		Amount: ci.PlayerPlugin.CalculateDamage(power),
+		Type:   a.DamageType(),
+		X:      x,
+		Y:      y,
+	}
+}
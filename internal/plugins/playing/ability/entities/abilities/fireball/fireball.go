@@ -2,42 +2,32 @@ package fireball
 
 import (
 	"game/internal/assets"
-	"game/internal/constants"
 	"game/internal/core"
-	"game/internal/helpers/collision"
 	"game/internal/plugins"
 	abilityentities "game/internal/plugins/playing/ability/entities/abilities"
-	"game/internal/plugins/playing/camera"
+	"game/internal/plugins/playing/ability/entities/abilities/projectile"
+	"game/internal/plugins/playing/ability/levels"
+	"game/internal/plugins/playing/combat/events"
+	"game/internal/plugins/playing/damage"
 	"image/color"
 	"log"
 	"math"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/vector"
 )
 
-type Projectile struct {
-	Active bool
-	Power  float64
+const (
+	// knockback pushes the enemies hit by a fireball
+	knockback = 12
 
-	X, Y       float64
-	Speed      float64
-	DirectionX float64
-	DirectionY float64
-
-	TargetX float64
-	TargetY float64
-
-	Radius float64
-
-	EnemiesDamaged map[string]bool
-
-	Animation *assets.Animation
-}
+	// arcHeight is how high the fireballs are lobbed, under their size so
+	// they still burn the enemies on the way
+	arcHeight = 40
+)
 
 type Ability struct {
 	plugins     *core.PluginManager
-	Projectiles []*Projectile
+	projectiles *projectile.Manager
 	Power       float64
 
 	// Shoot cooldown
@@ -46,6 +36,9 @@ type Ability struct {
 
 	Level int
 
+	// Projectiles stop at trees and rocks
+	BlockedByTerrain bool
+
 	FireballAnimation *assets.Animation
 }
 
@@ -63,12 +56,15 @@ func New() *Ability {
 		Power:             10,
 		ShootCooldown:     1.0,
 		Level:             1,
+		BlockedByTerrain:  false,
 		FireballAnimation: fireballAnimation,
+		projectiles:       projectile.NewManager(),
 	}
 }
 
 func (b *Ability) SetPluginManager(plugins *core.PluginManager) {
 	b.plugins = plugins
+	b.projectiles.SetPluginManager(plugins)
 }
 
 func (b *Ability) ID() string {
@@ -78,7 +74,7 @@ func (b *Ability) ID() string {
 func (b *Ability) AutoShot(deltaTime, x, y float64) {
 	b.ShootTimer += deltaTime
 
-	if b.ShootTimer >= b.ShootCooldown {
+	if b.ShootTimer >= abilityentities.Cooldown(b.plugins, b.ShootCooldown) {
 		b.Shoot(x, y)
 		b.ShootTimer = 0
 	}
@@ -88,27 +84,10 @@ func (b *Ability) Shoot(x, y float64) {
 	// Get enemy plugin to find closest enemy
 	enemyPlugin := b.plugins.GetPlugin("EnemySystem").(plugins.EnemyPlugin)
 
-	enemies := enemyPlugin.GetEnemies()
-
-	if len(enemies) > 0 {
-		// Find closest enemy
-		closestEnemy := enemies[0]
-		closestDist := math.MaxFloat64
-
-		for _, enemy := range enemies {
-			if !enemy.Active {
-				continue
-			}
+	nearest := enemyPlugin.NearestEnemies(x, y, 1)
 
-			dx := (enemy.X + enemy.Width/2) - x
-			dy := (enemy.Y + enemy.Height/2) - y
-			dist := math.Sqrt(dx*dx + dy*dy)
-
-			if dist < closestDist {
-				closestDist = dist
-				closestEnemy = enemy
-			}
-		}
+	if len(nearest) > 0 {
+		closestEnemy := nearest[0]
 
 		// Calcular direção
 		dx := (closestEnemy.X + closestEnemy.Width/2) - x
@@ -116,24 +95,38 @@ func (b *Ability) Shoot(x, y float64) {
 
 		distance := math.Sqrt(dx*dx + dy*dy)
 
-		dirX := dx / distance
-		dirY := dy / distance
-
-		// Create bullet targeting closest enemy
-		projectile := &Projectile{
-			X:              x,
-			Y:              y,
-			Speed:          500,
-			Active:         true,
-			Power:          b.Power,
-			DirectionX:     dirX,
-			DirectionY:     dirY,
-			Radius:         50,
-			EnemiesDamaged: make(map[string]bool),
-			Animation:      b.FireballAnimation,
+		angle := math.Atan2(dy/distance, dx/distance)
+
+		// Lobbed on the closest enemy, the extra projectiles land around it
+		count := abilityentities.ProjectileCount(b.plugins, 1)
+
+		for i := 0; i < count; i++ {
+			spread := (float64(i) - float64(count-1)/2) * 0.2
+
+			// Create bullet targeting closest enemy
+			size := abilityentities.Area(b.plugins, 50) * 2
+
+			b.projectiles.Spawn(projectile.Projectile{
+				X:                x - size/2,
+				Y:                y - size/2,
+				Speed:            500,
+				Power:            b.Power,
+				DirectionX:       math.Cos(angle + spread),
+				DirectionY:       math.Sin(angle + spread),
+				Movement:         projectile.Arcing,
+				TargetX:          x + math.Cos(angle+spread)*distance,
+				TargetY:          y + math.Sin(angle+spread)*distance,
+				ArcHeight:        arcHeight,
+				Width:            size,
+				Height:           size,
+				Round:            true,
+				Lifetime:         3,
+				BlockedByTerrain: b.BlockedByTerrain,
+				Color:            color.RGBA{200, 255, 0, 255},
+				Mechanics:        levels.Mechanics(b.ID(), b.Level),
+				Animation:        b.FireballAnimation,
+			})
 		}
-
-		b.Projectiles = append(b.Projectiles, projectile)
 	}
 }
 
@@ -142,83 +135,19 @@ func (b *Ability) Update(wui abilityentities.AbilityUpdateInput) {
 
 	b.FireballAnimation.Update(wui.DeltaTime)
 
-	for _, projectile := range b.Projectiles {
-		if projectile.Active {
-			dx := projectile.DirectionX
-			dy := projectile.DirectionY
-
-			// Calculate distance
-			distance := math.Sqrt(dx*dx + dy*dy)
-
-			if distance > 0 {
-				// Normalize direction
-				dx /= distance
-				dy /= distance
-
-				// Update position
-				projectile.X += dx * projectile.Speed * wui.DeltaTime
-				projectile.Y += dy * projectile.Speed * wui.DeltaTime
-			}
-
-			cameraPlugin := b.plugins.GetPlugin("CameraSystem").(*camera.CameraPlugin)
-			cameraX, cameraY := cameraPlugin.GetPosition()
-			// Check if projectile is too far from camera view
-			screenX := projectile.X - cameraX
-			screenY := projectile.Y - cameraY
-			margin := float64(200) // Larger margin before deactivating
-
-			if screenX < -margin ||
-				screenX > constants.ScreenWidth+margin ||
-				screenY < -margin ||
-				screenY > constants.ScreenHeight+margin {
-
-				projectile.Active = false
-			}
-		}
-	}
+	b.projectiles.Update(wui)
 }
 
 func (b *Ability) Draw(screen *ebiten.Image, wdi abilityentities.AbilityDrawInput) {
-	for _, projectile := range b.Projectiles {
-		if projectile.Active {
-			// Draw bullet relative to camera position
-			screenX := projectile.X - wdi.CameraX
-			screenY := projectile.Y - wdi.CameraY
-
-			// Only draw if on screen
-			if screenX >= -5 && screenX <= constants.ScreenWidth+5 &&
-				screenY >= -5 && screenY <= constants.ScreenHeight+5 {
-
-				vector.DrawFilledCircle(
-					screen,
-					float32(screenX),
-					float32(screenY),
-					float32(projectile.Radius),
-					color.RGBA{200, 255, 0, 255},
-					true)
-
-				squareSize := projectile.Radius * 2
-				drawInput := assets.DrawInput{
-					Width:  squareSize,
-					Height: squareSize,
-					X:      screenX - squareSize/2,
-					Y:      screenY - squareSize/2,
-				}
-
-				projectile.Animation.Draw(screen, drawInput)
-			}
-		}
-	}
-
-	return
+	b.projectiles.Draw(screen, wdi)
 }
 
 func (b *Ability) GetPower() float64 {
 	return b.Power
 }
 
-func (*Ability) DamageType() string {
-	return "projectil"
+func (*Ability) DamageType() damage.Type {
+	return damage.Fire
 }
 
 func (*Ability) AttackSpeed() float64 {
@@ -243,38 +172,13 @@ func (b *Ability) IncreaseLevel() {
 	b.ShootCooldown -= 0.1
 }
 
-func (b *Ability) Combat(ci abilityentities.CombatInput) abilityentities.CombatOutput {
-	enemy := ci.Enemy
-	pp := ci.PlayerPlugin
-	enemyGotDamaged := false
-	damage := 0.0
-	critical := false
-
-	for _, projectil := range b.Projectiles {
-		if enemy.Active && projectil.Active {
-			checkSpriteCollisionInput := collision.CheckSpriteCollisionInput{
-				X1:      projectil.X,
-				Y1:      projectil.Y,
-				Width1:  projectil.Radius * 2,
-				Height1: projectil.Radius * 2,
-				X2:      enemy.X,
-				Y2:      enemy.Y,
-				Width2:  enemy.Width,
-				Height2: enemy.Height,
-			}
-
-			if collision.CheckSpriteCollision(checkSpriteCollisionInput) {
-				damage, critical = pp.CalculateDamage(projectil.Power)
-
-				projectil.EnemiesDamaged[enemy.UUID] = true
-				enemyGotDamaged = true
-			}
-		}
-	}
+func (b *Ability) Colliders() []abilityentities.Collider {
+	return b.projectiles.Colliders(func(p *projectile.Projectile, ci abilityentities.CombatInput) events.DamageEvent {
+		centerX, centerY := p.Center()
 
-	return abilityentities.CombatOutput{
-		EnemyGotDamaged: enemyGotDamaged,
-		Damage:          damage,
-		CriticalDamage:  critical,
-	}
+		event := abilityentities.Hit(ci, b, p.Power, centerX, centerY)
+		event.Knockback = knockback
+
+		return event
+	})
 }
@@ -0,0 +1,17 @@
+package abilities
+
+import "game/internal/helpers/shapes"
+
+// Guard is an area of an ability stopping the enemy projectiles entering it
+type Guard struct {
+	Shape shapes.Shape
+
+	// Reflect sends the projectiles back to the enemies instead of
+	// destroying them
+	Reflect bool
+}
+
+// Guarding is implemented by the abilities able to stop enemy projectiles
+type Guarding interface {
+	Guards() []Guard
+}
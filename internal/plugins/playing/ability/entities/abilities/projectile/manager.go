@@ -0,0 +1,215 @@
+package projectile
+
+import (
+	"game/internal/assets"
+	"game/internal/constants"
+	"game/internal/core"
+	abilityentities "game/internal/plugins/playing/ability/entities/abilities"
+	"game/internal/plugins/playing/combat/events"
+	"game/internal/plugins/playing/enemy/director"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// HitFunc turns a projectile touching an enemy into the damage dealt
+type HitFunc func(p *Projectile, ci abilityentities.CombatInput) events.DamageEvent
+
+// Manager moves, draws and recycles the projectiles of an ability
+type Manager struct {
+	plugins *core.PluginManager
+
+	projectiles []*Projectile
+	free        []*Projectile
+}
+
+func NewManager() *Manager {
+	return &Manager{}
+}
+
+func (m *Manager) SetPluginManager(plugins *core.PluginManager) {
+	m.plugins = plugins
+}
+
+// Spawn shoots a copy of the projectile, reusing a removed one if any
+func (m *Manager) Spawn(spec Projectile) *Projectile {
+	var p *Projectile
+
+	if n := len(m.free); n > 0 {
+		p = m.free[n-1]
+		m.free[n-1] = nil
+		m.free = m.free[:n-1]
+	} else {
+		p = &Projectile{}
+	}
+
+	damaged := p.EnemiesDamaged
+	if damaged == nil {
+		damaged = make(map[string]float64)
+	}
+	clear(damaged)
+
+	*p = spec
+	p.Active = true
+	p.Age = 0
+	p.Traveled = 0
+	p.Returning = false
+	p.landed = false
+	p.EnemiesDamaged = damaged
+	p.start()
+
+	m.projectiles = append(m.projectiles, p)
+
+	return p
+}
+
+// Projectiles returns the active projectiles
+func (m *Manager) Projectiles() []*Projectile {
+	return m.projectiles
+}
+
+// Update moves the projectiles and recycles the ones expired, out of range,
+// far from the camera or blocked by the terrain
+func (m *Manager) Update(wui abilityentities.AbilityUpdateInput) {
+	for _, p := range m.projectiles {
+		if !p.Active {
+			continue
+		}
+
+		p.Age += wui.DeltaTime
+
+		if !p.move(wui.DeltaTime, wui.PlayerX, wui.PlayerY) {
+			p.Active = false
+			continue
+		}
+
+		if p.Lifetime > 0 && p.Age >= p.Lifetime {
+			p.Active = false
+		}
+
+		if p.Range > 0 && p.Traveled >= p.Range {
+			p.Active = false
+		}
+
+		screenX := p.X - wui.CameraX
+		screenY := p.Y - wui.CameraY
+
+		// Culled with the enemies they could still hit
+		if screenX < -director.CullMargin ||
+			screenX > constants.ScreenWidth+director.CullMargin ||
+			screenY < -director.CullMargin ||
+			screenY > constants.ScreenHeight+director.CullMargin {
+
+			p.Active = false
+		}
+
+		// Arcing projectiles fly over the obstacles
+		if p.BlockedByTerrain && p.Movement != Arcing {
+			centerX, centerY := p.Center()
+
+			if abilityentities.HitsTerrain(m.plugins, centerX, centerY) {
+				p.Active = false
+			}
+		}
+	}
+
+	m.recycle()
+}
+
+// recycle moves the inactive projectiles to the free list
+func (m *Manager) recycle() {
+	active := m.projectiles[:0]
+
+	for _, p := range m.projectiles {
+		if p.Active {
+			active = append(active, p)
+			continue
+		}
+
+		p.Target = nil
+		p.Animation = nil
+		m.free = append(m.free, p)
+	}
+
+	clear(m.projectiles[len(active):])
+	m.projectiles = active
+}
+
+func (m *Manager) Draw(screen *ebiten.Image, wdi abilityentities.AbilityDrawInput) {
+	for _, p := range m.projectiles {
+		if !p.Active {
+			continue
+		}
+
+		screenX := p.X - wdi.CameraX
+		screenY := p.Y - wdi.CameraY - p.Lift()
+
+		// Only draw if on screen
+		if screenX < -p.Width || screenX > constants.ScreenWidth+p.Width ||
+			screenY < -p.Height || screenY > constants.ScreenHeight+p.Height {
+			continue
+		}
+
+		if p.Color.A > 0 {
+			if p.Round {
+				vector.DrawFilledCircle(
+					screen,
+					float32(screenX+p.Width/2),
+					float32(screenY+p.Height/2),
+					float32(p.Width/2),
+					p.Color,
+					true)
+			} else {
+				vector.DrawFilledRect(
+					screen,
+					float32(screenX),
+					float32(screenY),
+					float32(p.Width),
+					float32(p.Height),
+					p.Color,
+					true)
+			}
+		}
+
+		if p.Animation != nil {
+			p.Animation.Draw(screen, assets.DrawInput{
+				Width:  p.Width,
+				Height: p.Height,
+				X:      screenX,
+				Y:      screenY,
+			})
+		}
+	}
+}
+
+// Colliders returns a collider per projectile, hit is called for the enemies
+// touched by a projectile and the mechanics are applied after
+func (m *Manager) Colliders(hit HitFunc) []abilityentities.Collider {
+	colliders := make([]abilityentities.Collider, 0, len(m.projectiles))
+
+	for _, p := range m.projectiles {
+		// Lobbed projectiles only hit when landing
+		if !p.Active || p.Lift() > p.Height {
+			continue
+		}
+
+		colliders = append(colliders, abilityentities.Collider{
+			Shape: p.Shape(),
+			Hit: func(ci abilityentities.CombatInput) (events.DamageEvent, bool) {
+				enemy := ci.Enemy
+
+				if !enemy.Active || !p.Active || !p.canHit(enemy) {
+					return events.DamageEvent{}, false
+				}
+
+				event := hit(p, ci)
+
+				m.impact(p, enemy, ci.EnemyPlugin)
+
+				return event, true
+			},
+		})
+	}
+
+	return colliders
+}
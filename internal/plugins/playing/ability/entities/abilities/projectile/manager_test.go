@@ -0,0 +1,119 @@
+package projectile
+
+import (
+	abilityentities "game/internal/plugins/playing/ability/entities/abilities"
+	enemyentities "game/internal/plugins/playing/enemy/entities"
+	"testing"
+)
+
+// frame is a 60 TPS update with the camera on the origin
+var frame = abilityentities.AbilityUpdateInput{DeltaTime: 1.0 / 60.0}
+
+// bolt is a projectile flying right from the top-left of the screen
+func bolt() Projectile {
+	return Projectile{X: 100, Y: 100, Width: 10, Height: 10, Speed: 60, DirectionX: 1}
+}
+
+// updates runs the frames and returns how many the projectile stayed active
+func updates(m *Manager, p *Projectile, frames int) int {
+	for i := 0; i < frames; i++ {
+		m.Update(frame)
+
+		if !p.Active {
+			return i
+		}
+	}
+
+	return frames
+}
+
+func TestSpawnResetsRecycledProjectiles(t *testing.T) {
+	m := NewManager()
+
+	spec := bolt()
+	spec.Lifetime = 0.25
+
+	first := m.Spawn(spec)
+	first.EnemiesDamaged["enemy"] = 0.1
+	first.Target = &enemyentities.Enemy{}
+
+	updates(m, first, 60)
+
+	if len(m.Projectiles()) != 0 || len(m.free) != 1 {
+		t.Fatalf("expired projectile not recycled, %v active %v free", len(m.Projectiles()), len(m.free))
+	}
+
+	second := m.Spawn(bolt())
+
+	if second != first {
+		t.Fatalf("Spawn did not reuse the recycled projectile")
+	}
+
+	if !second.Active || second.Age != 0 || second.Traveled != 0 || second.Lifetime != 0 {
+		t.Errorf("Spawn kept the state of the recycled projectile: %+v", second)
+	}
+
+	if second.Target != nil || len(second.EnemiesDamaged) != 0 {
+		t.Errorf("Spawn kept the target or the enemies hit by the recycled projectile")
+	}
+
+	if len(m.Projectiles()) != 1 || len(m.free) != 0 {
+		t.Errorf("Spawn left %v active %v free, want 1 active", len(m.Projectiles()), len(m.free))
+	}
+}
+
+func TestUpdateExpiresProjectiles(t *testing.T) {
+	tests := []struct {
+		name   string
+		spec   func(*Projectile)
+		frames int
+	}{
+		{"lifetime", func(p *Projectile) { p.Lifetime = 0.51 }, 30},
+		{"range", func(p *Projectile) { p.Range = 30.5 }, 30},
+		{"out of the camera", func(p *Projectile) { p.X, p.DirectionX = -190, -1 }, 10},
+		{"unlimited", func(*Projectile) {}, 120},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager()
+
+			spec := bolt()
+			tt.spec(&spec)
+
+			if got := updates(m, m.Spawn(spec), 120); got != tt.frames {
+				t.Errorf("projectile lasted %v frames, want %v", got, tt.frames)
+			}
+		})
+	}
+}
+
+func TestArcingProjectilesHitOnTheLandingFrame(t *testing.T) {
+	m := NewManager()
+
+	spec := bolt()
+	spec.Movement = Arcing
+	spec.TargetX, spec.TargetY = 135, 105
+	spec.ArcHeight = 100
+
+	p := m.Spawn(spec)
+
+	// About half a second to travel 30 pixels at 60 per second
+	for i := 0; i < 60 && !p.landed; i++ {
+		m.Update(frame)
+	}
+
+	if centerX, centerY := p.Center(); !p.Active || centerX != spec.TargetX || centerY != spec.TargetY {
+		t.Fatalf("projectile at %v,%v active %v, want landed on the target", centerX, centerY, p.Active)
+	}
+
+	if colliders := m.Colliders(nil); len(colliders) != 1 {
+		t.Fatalf("landed projectile has %v colliders, want 1", len(colliders))
+	}
+
+	m.Update(frame)
+
+	if p.Active || len(m.Projectiles()) != 0 {
+		t.Errorf("projectile still active the frame after landing")
+	}
+}
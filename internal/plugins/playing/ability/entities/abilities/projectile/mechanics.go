@@ -0,0 +1,176 @@
+package projectile
+
+import (
+	"game/internal/plugins"
+	enemyentities "game/internal/plugins/playing/enemy/entities"
+	"math"
+)
+
+const (
+	// chainTurnRate lets the chained projectiles home on their target
+	chainTurnRate = 4 * math.Pi
+
+	// splitPower is the share of the power kept by the split projectiles
+	splitPower = 0.5
+)
+
+// Mechanics change what happens when a projectile hits an enemy, they come
+// from the ability level data
+type Mechanics struct {
+	// Pierce is how many enemies are passed through before stopping, -1
+	// never stops
+	Pierce int `json:"pierce"`
+
+	// HitCooldown is the time before the same enemy can be hit again, zero
+	// hits every enemy once
+	HitCooldown float64 `json:"hitCooldown"`
+
+	// Ricochet is how many times a stopping projectile bounces to the
+	// nearest enemy instead
+	Ricochet int `json:"ricochet"`
+
+	// Chain is how many times the hit jumps to the next nearest enemy
+	Chain int `json:"chain"`
+
+	// SeekRange is how far ricochets and chains look for the next enemy
+	SeekRange float64 `json:"seekRange"`
+
+	// Split is how many projectiles are shot when stopping, fanned out on
+	// SplitAngle degrees
+	Split      int     `json:"split"`
+	SplitAngle float64 `json:"splitAngle"`
+}
+
+// canHit tells whether the enemy is not hit or its hit cooldown is over
+func (p *Projectile) canHit(enemy *enemyentities.Enemy) bool {
+	last, hit := p.EnemiesDamaged[enemy.UUID]
+	if !hit {
+		return true
+	}
+
+	return p.HitCooldown > 0 && p.Age-last >= p.HitCooldown
+}
+
+// impact applies the mechanics once the projectile hit the enemy
+func (m *Manager) impact(p *Projectile, enemy *enemyentities.Enemy, enemyPlugin plugins.EnemyPlugin) {
+	p.EnemiesDamaged[enemy.UUID] = p.Age
+
+	centerX := enemy.X + enemy.Width/2
+	centerY := enemy.Y + enemy.Height/2
+
+	if p.Chain > 0 {
+		m.chain(p, enemyPlugin, centerX, centerY)
+	}
+
+	switch {
+	case p.Pierce < 0:
+	case p.Pierce > 0:
+		p.Pierce--
+	case p.Ricochet > 0 && m.ricochet(p, enemyPlugin, centerX, centerY):
+		p.Ricochet--
+	default:
+		p.Active = false
+
+		if p.Split > 0 {
+			m.split(p)
+		}
+	}
+}
+
+// next returns the nearest enemy not hit yet within the seek range
+func (p *Projectile) next(enemyPlugin plugins.EnemyPlugin, x, y float64) *enemyentities.Enemy {
+	for _, enemy := range enemyPlugin.NearestEnemies(x, y, len(p.EnemiesDamaged)+1) {
+		if _, hit := p.EnemiesDamaged[enemy.UUID]; hit {
+			continue
+		}
+
+		distance := math.Hypot(enemy.X+enemy.Width/2-x, enemy.Y+enemy.Height/2-y)
+		if distance > p.SeekRange {
+			return nil
+		}
+
+		return enemy
+	}
+
+	return nil
+}
+
+// ricochet turns the projectile toward the next enemy
+func (m *Manager) ricochet(p *Projectile, enemyPlugin plugins.EnemyPlugin, x, y float64) bool {
+	target := p.next(enemyPlugin, x, y)
+	if target == nil {
+		return false
+	}
+
+	centerX, centerY := p.Center()
+	p.aim(target, centerX, centerY)
+
+	if p.Movement == Homing {
+		p.Target, p.targetUUID = target, target.UUID
+	} else {
+		p.Movement = Straight
+	}
+
+	return true
+}
+
+// chain shoots a homing projectile from the hit enemy to the next one, the
+// projectile itself only chains once
+func (m *Manager) chain(p *Projectile, enemyPlugin plugins.EnemyPlugin, x, y float64) {
+	target := p.next(enemyPlugin, x, y)
+	chain := p.Chain
+	p.Chain = 0
+
+	if target == nil {
+		return
+	}
+
+	spec := *p
+	spec.X, spec.Y = x-p.Width/2, y-p.Height/2
+	spec.Movement = Homing
+	spec.Target = target
+	spec.TurnRate = chainTurnRate
+	spec.Range = p.SeekRange * 2
+	spec.Mechanics = Mechanics{Chain: chain - 1, SeekRange: p.SeekRange}
+	spec.aim(target, x, y)
+
+	m.Spawn(spec).inherit(p)
+}
+
+// split fans out weaker projectiles from the stopped one
+func (m *Manager) split(p *Projectile) {
+	angle := math.Atan2(p.DirectionY, p.DirectionX)
+	fan := p.SplitAngle * math.Pi / 180
+
+	for i := 0; i < p.Split; i++ {
+		offset := 0.0
+		if p.Split > 1 {
+			offset = fan * (float64(i)/float64(p.Split-1) - 0.5)
+		}
+
+		spec := *p
+		spec.Power = p.Power * splitPower
+		spec.Movement = Straight
+		spec.DirectionX = math.Cos(angle + offset)
+		spec.DirectionY = math.Sin(angle + offset)
+		spec.Target = nil
+		spec.Mechanics = Mechanics{}
+
+		m.Spawn(spec).inherit(p)
+	}
+}
+
+// aim points the projectile from the position to the enemy center
+func (p *Projectile) aim(enemy *enemyentities.Enemy, x, y float64) {
+	angle := math.Atan2(enemy.Y+enemy.Height/2-y, enemy.X+enemy.Width/2-x)
+
+	p.DirectionX, p.DirectionY = math.Cos(angle), math.Sin(angle)
+}
+
+// inherit keeps the enemies hit by the parent out of reach of a spawned
+// projectile, until the hit cooldown is over
+func (p *Projectile) inherit(parent *Projectile) {
+	for uuid := range parent.EnemiesDamaged {
+		p.EnemiesDamaged[uuid] = 0
+	}
+}
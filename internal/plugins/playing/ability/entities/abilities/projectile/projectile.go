@@ -0,0 +1,220 @@
+package projectile
+
+import (
+	"game/internal/assets"
+	"game/internal/helpers/shapes"
+	enemyentities "game/internal/plugins/playing/enemy/entities"
+	"image/color"
+	"math"
+)
+
+// Movement is how a projectile travels once shot
+type Movement int
+
+const (
+	// Straight keeps the initial direction
+	Straight Movement = iota
+
+	// Homing turns toward the target enemy
+	Homing
+
+	// Arcing is lobbed to the target position and lands there
+	Arcing
+
+	// Boomerang flies out and comes back to the player
+	Boomerang
+)
+
+// Projectile is shared by the abilities shooting projectiles, X and Y is the
+// top-left corner
+type Projectile struct {
+	Active bool
+	Power  float64
+
+	X, Y       float64
+	Speed      float64
+	DirectionX float64
+	DirectionY float64
+
+	Width  float64
+	Height float64
+
+	// Round projectiles are drawn and hit as circles
+	Round bool
+
+	Movement Movement
+
+	// Lifetime in seconds and Range in pixels, zero means unlimited
+	Lifetime float64
+	Range    float64
+
+	Age      float64
+	Traveled float64
+
+	// Projectiles stop at trees and rocks
+	BlockedByTerrain bool
+
+	// Homing
+	Target   *enemyentities.Enemy
+	TurnRate float64
+
+	// Arcing
+	TargetX   float64
+	TargetY   float64
+	ArcHeight float64
+
+	// Boomerang
+	ReturnAfter float64
+	Returning   bool
+
+	Color     color.RGBA
+	Animation *assets.Animation
+
+	Mechanics
+
+	// EnemiesDamaged is the age of the projectile at the last hit of each
+	// enemy by UUID
+	EnemiesDamaged map[string]float64
+
+	targetUUID  string
+	startX      float64
+	startY      float64
+	arcDuration float64
+	landed      bool
+}
+
+// Center returns the world position of the projectile center
+func (p *Projectile) Center() (float64, float64) {
+	return p.X + p.Width/2, p.Y + p.Height/2
+}
+
+// Shape is the hitbox of the projectile
+func (p *Projectile) Shape() shapes.Shape {
+	if p.Round {
+		centerX, centerY := p.Center()
+
+		return shapes.Circle{X: centerX, Y: centerY, Radius: p.Width / 2}
+	}
+
+	return shapes.AABB{X: p.X, Y: p.Y, Width: p.Width, Height: p.Height}
+}
+
+// Lift is the height above the ground of an arcing projectile
+func (p *Projectile) Lift() float64 {
+	if p.Movement != Arcing || p.arcDuration == 0 {
+		return 0
+	}
+
+	t := math.Min(p.Age/p.arcDuration, 1)
+
+	return p.ArcHeight * 4 * t * (1 - t)
+}
+
+func (p *Projectile) start() {
+	p.startX, p.startY = p.X, p.Y
+
+	if p.Target != nil {
+		p.targetUUID = p.Target.UUID
+	}
+
+	switch p.Movement {
+	case Arcing:
+		centerX, centerY := p.Center()
+		distance := math.Hypot(p.TargetX-centerX, p.TargetY-centerY)
+
+		if p.Speed > 0 {
+			p.arcDuration = distance / p.Speed
+		}
+	}
+}
+
+// move advances the projectile by its movement model around the player
+// center, it returns false once the projectile reached its end
+func (p *Projectile) move(deltaTime, playerX, playerY float64) bool {
+	x, y := p.X, p.Y
+
+	switch p.Movement {
+	case Homing:
+		p.steer(deltaTime)
+		p.forward(deltaTime)
+
+	case Arcing:
+		t := 1.0
+		if p.arcDuration > 0 {
+			t = math.Min(p.Age/p.arcDuration, 1)
+		}
+
+		startX, startY := p.startX+p.Width/2, p.startY+p.Height/2
+
+		// The landing frame still hits, the projectile ends on the next one
+		if p.landed {
+			return false
+		}
+
+		p.X = startX + (p.TargetX-startX)*t - p.Width/2
+		p.Y = startY + (p.TargetY-startY)*t - p.Height/2
+
+		p.landed = t >= 1
+
+	case Boomerang:
+		if p.Returning {
+			centerX, centerY := p.Center()
+			dx, dy := playerX-centerX, playerY-centerY
+			distance := math.Hypot(dx, dy)
+
+			if distance <= p.Speed*deltaTime {
+				return false
+			}
+
+			p.DirectionX, p.DirectionY = dx/distance, dy/distance
+		}
+
+		p.forward(deltaTime)
+
+		if !p.Returning && p.Traveled+math.Hypot(p.X-x, p.Y-y) >= p.ReturnAfter {
+			p.Returning = true
+
+			// The way back hits again
+			clear(p.EnemiesDamaged)
+		}
+
+	default:
+		p.forward(deltaTime)
+	}
+
+	p.Traveled += math.Hypot(p.X-x, p.Y-y)
+
+	return true
+}
+
+func (p *Projectile) forward(deltaTime float64) {
+	distance := math.Hypot(p.DirectionX, p.DirectionY)
+	if distance == 0 {
+		return
+	}
+
+	p.X += p.DirectionX / distance * p.Speed * deltaTime
+	p.Y += p.DirectionY / distance * p.Speed * deltaTime
+}
+
+// steer turns the direction toward the target, limited by the turn rate
+func (p *Projectile) steer(deltaTime float64) {
+	target := p.Target
+	if target == nil || !target.Active || target.UUID != p.targetUUID {
+		return
+	}
+
+	centerX, centerY := p.Center()
+
+	current := math.Atan2(p.DirectionY, p.DirectionX)
+	wanted := math.Atan2(
+		target.Y+target.Height/2-centerY,
+		target.X+target.Width/2-centerX)
+
+	turn := math.Remainder(wanted-current, 2*math.Pi)
+	limit := p.TurnRate * deltaTime
+	turn = math.Max(-limit, math.Min(limit, turn))
+
+	p.DirectionX = math.Cos(current + turn)
+	p.DirectionY = math.Sin(current + turn)
+}
@@ -1,16 +1,23 @@
 package protection
 
 import (
-	"game/internal/constants"
 	"game/internal/core"
-	"game/internal/helpers/collision"
+	"game/internal/plugins"
 	abilityentities "game/internal/plugins/playing/ability/entities/abilities"
+	combatentities "game/internal/plugins/playing/combat/entities"
+	"game/internal/plugins/playing/combat/events"
+	"game/internal/plugins/playing/damage"
 	"image/color"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/vector"
 )
 
+const (
+	guardLevel   = 3
+	reflectLevel = 5
+)
+
 type Protection struct {
 	plugins *core.PluginManager
 	Power   float64
@@ -57,7 +64,7 @@ func (p *Protection) Draw(screen *ebiten.Image, wdi abilityentities.AbilityDrawI
 		screen,
 		float32(screenX),
 		float32(screenY),
-		float32(p.Radius),
+		float32(p.GetRadius()),
 		color.RGBA{111, 222, 111, 2},
 		true)
 
@@ -68,8 +75,8 @@ func (d *Protection) GetPower() float64 {
 	return d.Power
 }
 
-func (*Protection) DamageType() string {
-	return "area"
+func (*Protection) DamageType() damage.Type {
+	return damage.Arcane
 }
 
 func (*Protection) AttackSpeed() float64 {
@@ -80,7 +87,7 @@ func (*Protection) AutoShot(deltaTime, x, y float64) {
 }
 
 func (p *Protection) GetRadius() float64 {
-	return p.Radius
+	return abilityentities.Area(p.plugins, p.Radius)
 }
 
 func (p *Protection) CurrentLevel() int {
@@ -97,59 +104,65 @@ func (p *Protection) IncreaseLevel() {
 	p.Radius += 10
 }
 
-func (p *Protection) Combat(ci abilityentities.CombatInput) abilityentities.CombatOutput {
-	enemy := ci.Enemy
-	pp := ci.PlayerPlugin
-	enemyGotDamaged := false
-	damage := 0.0
-	critical := false
+// area is the aura around the player
+func (p *Protection) area() combatentities.Radius {
+	playerPlugin := p.plugins.GetPlugin("PlayerSystem").(plugins.PlayerPlugin)
+	playerX, playerY := playerPlugin.GetPosition()
 
-	if enemy.Active {
-		enemyUUID := enemy.UUID
+	return combatentities.Radius{
+		X:       playerX,
+		Y:       playerY,
+		Radius:  p.GetRadius(),
+		Targets: combatentities.TargetEnemies,
+	}
+}
 
-		screenCenterX := float64(constants.ScreenWidth) / 2
-		screenCenterY := float64(constants.ScreenHeight) / 2
+// Guards destroys the enemy projectiles entering the aura from level 3 and
+// reflects them at level 5
+func (p *Protection) Guards() []abilityentities.Guard {
+	if p.Level < guardLevel {
+		return nil
+	}
 
-		circleCenterX := screenCenterX + ci.CameraX - p.GetRadius()
-		circleCenterY := screenCenterY + ci.CameraY - p.GetRadius()
+	return []abilityentities.Guard{{
+		Shape:   p.area().Shape(),
+		Reflect: p.Level >= reflectLevel,
+	}}
+}
 
-		enemyCenterX := enemy.X + enemy.Width/2
-		enemyCenterY := enemy.Y + enemy.Height/2
+func (p *Protection) Colliders() []abilityentities.Collider {
+	area := p.area()
 
-		checkSpriteCollisionInput := collision.CheckSpriteCollisionInput{
-			X1:      circleCenterX,
-			Y1:      circleCenterY,
-			Width1:  p.GetRadius() * 2,
-			Height1: p.GetRadius() * 2,
-			X2:      enemyCenterX,
-			Y2:      enemyCenterY,
-			Width2:  enemy.Width,
-			Height2: enemy.Height,
-		}
+	return []abilityentities.Collider{{
+		Shape: area.Shape(),
+		Hit: func(ci abilityentities.CombatInput) (events.DamageEvent, bool) {
+			enemy := ci.Enemy
 
-		if collision.CheckSpriteCollision(checkSpriteCollisionInput) {
-			lastAreaDamageDeltaTime, exists := p.LastDamageDeltaTimeByEnemy[enemyUUID]
+			if !enemy.Active {
+				return events.DamageEvent{}, false
+			}
+
+			var event events.DamageEvent
+			hit := false
+
+			lastAreaDamageDeltaTime, exists := p.LastDamageDeltaTimeByEnemy[enemy.UUID]
 
 			if !exists {
 				lastAreaDamageDeltaTime = 0
 			}
 
 			if lastAreaDamageDeltaTime >= p.AttackSpeed() {
-				damage, critical = pp.CalculateDamage(p.GetPower())
-				enemyGotDamaged = true
+				event = abilityentities.Hit(ci, p, p.GetPower(), area.X, area.Y)
+				hit = true
 
 				lastAreaDamageDeltaTime = 0
 			} else {
 				lastAreaDamageDeltaTime += ci.DeltaTime
 			}
 
-			p.LastDamageDeltaTimeByEnemy[enemyUUID] = lastAreaDamageDeltaTime
-		}
-	}
+			p.LastDamageDeltaTimeByEnemy[enemy.UUID] = lastAreaDamageDeltaTime
 
-	return abilityentities.CombatOutput{
-		EnemyGotDamaged: enemyGotDamaged,
-		Damage:          damage,
-		CriticalDamage:  critical,
-	}
+			return event, hit
+		},
+	}}
 }
@@ -0,0 +1,21 @@
+package abilities
+
+import (
+	"game/internal/core"
+	"game/internal/plugins/playing/scenario"
+)
+
+// HitsTerrain tells whether a projectile at the world position is inside an
+// obstacle, abilities opt in to being blocked by the terrain
+func HitsTerrain(pm *core.PluginManager, x, y float64) bool {
+	if pm == nil {
+		return false
+	}
+
+	scenarioPlugin, ok := pm.GetPlugin("ScenarioSystem").(*scenario.ScenarioPlugin)
+	if !ok {
+		return false
+	}
+
+	return !scenarioPlugin.IsTileWalkable(x, y)
+}
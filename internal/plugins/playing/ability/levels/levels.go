@@ -0,0 +1,141 @@
+package levels
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"game/internal/assets"
+	"game/internal/plugins/playing/ability/entities/abilities/projectile"
+	"path"
+	"strings"
+)
+
+const definitionsPath = "assets/data/abilities"
+
+// Levels are loaded from the embedded ability files by Load, keyed by the
+// ability ID, the first entry is level 1
+var Levels = map[string][]projectile.Mechanics{}
+
+type definition struct {
+	Ability string                 `json:"ability"`
+	Levels  []projectile.Mechanics `json:"levels"`
+}
+
+// Load reads every ability level file, validating all of them before failing
+func Load() error {
+	entries, err := assets.ReadDir(definitionsPath)
+	if err != nil {
+		return fmt.Errorf("ability levels: %w", err)
+	}
+
+	var errs []error
+
+	loaded := map[string][]projectile.Mechanics{}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+
+		file := path.Join(definitionsPath, entry.Name())
+
+		d, err := loadDefinition(file)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", file, err))
+			continue
+		}
+
+		if _, exists := loaded[d.Ability]; exists {
+			errs = append(errs, fmt.Errorf("%s: duplicated ability %q", file, d.Ability))
+			continue
+		}
+
+		loaded[d.Ability] = d.Levels
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("ability levels:\n%w", errors.Join(errs...))
+	}
+
+	Levels = loaded
+
+	return nil
+}
+
+// Mechanics returns the projectile mechanics of the ability at the level,
+// levels past the data keep the last entry
+func Mechanics(ability string, level int) projectile.Mechanics {
+	levels := Levels[ability]
+	if len(levels) == 0 {
+		return projectile.Mechanics{}
+	}
+
+	index := min(max(level, 1), len(levels)) - 1
+
+	return levels[index]
+}
+
+func loadDefinition(file string) (definition, error) {
+	var d definition
+
+	data, err := assets.ReadFile(file)
+	if err != nil {
+		return d, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&d); err != nil {
+		return d, err
+	}
+
+	return d, d.validate()
+}
+
+func (d definition) validate() error {
+	var errs []error
+
+	if d.Ability == "" {
+		errs = append(errs, errors.New("ability is required"))
+	}
+
+	if len(d.Levels) == 0 {
+		errs = append(errs, errors.New("at least one level is required"))
+	}
+
+	for i, level := range d.Levels {
+		if err := validateLevel(level); err != nil {
+			errs = append(errs, fmt.Errorf("level %d: %w", i+1, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateLevel(m projectile.Mechanics) error {
+	var errs []error
+
+	if m.Pierce < -1 {
+		errs = append(errs, errors.New("pierce must be -1 or more"))
+	}
+
+	if m.HitCooldown < 0 || m.Ricochet < 0 || m.Chain < 0 || m.Split < 0 {
+		errs = append(errs, errors.New("hitCooldown, ricochet, chain and split can't be negative"))
+	}
+
+	if (m.Ricochet > 0 || m.Chain > 0) && m.SeekRange <= 0 {
+		errs = append(errs, errors.New("ricochet and chain need a positive seekRange"))
+	}
+
+	if m.SplitAngle < 0 || m.SplitAngle > 360 {
+		errs = append(errs, errors.New("splitAngle must be between 0 and 360"))
+	}
+
+	if m.Split > 1 && m.SplitAngle == 0 {
+		errs = append(errs, errors.New("split of more than one projectile needs a splitAngle"))
+	}
+
+	return errors.Join(errs...)
+}
@@ -51,3 +51,8 @@ func (cp *CameraPlugin) Draw(*ebiten.Image) {
 func (cp *CameraPlugin) GetPosition() (float64, float64) {
 	return cp.camera.X, cp.camera.Y
 }
+
+// GetViewSize returns the size of the world area visible on screen
+func (cp *CameraPlugin) GetViewSize() (float64, float64) {
+	return constants.ScreenWidth, constants.ScreenHeight
+}
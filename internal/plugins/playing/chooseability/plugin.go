@@ -1,47 +1,57 @@
 package chooseability
 
 import (
+	"fmt"
 	"game/internal/core"
 	"game/internal/plugins/menu/fontface"
+	"game/internal/plugins/playing/ability"
 	abilitiesentities "game/internal/plugins/playing/ability/entities/abilities"
 	abilitiesentitiesbasic "game/internal/plugins/playing/ability/entities/abilities/basic"
 	abilitiesentitiesdagger "game/internal/plugins/playing/ability/entities/abilities/dagger"
 	abilitiesentitiesfireball "game/internal/plugins/playing/ability/entities/abilities/fireball"
 	abilitiesentitiesprotection "game/internal/plugins/playing/ability/entities/abilities/protection"
+	"game/internal/plugins/playing/passive"
 
 	"image/color"
+	"math/rand/v2"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/text"
 )
 
+const maxOptions = 4
+
+var optionKeys = []ebiten.Key{ebiten.Key1, ebiten.Key2, ebiten.Key3, ebiten.Key4}
+
+// option is one level-up reward, either an ability or a passive item
+type option struct {
+	name   string
+	level  int
+	choose func()
+}
+
 type ChooseAbilityPlugin struct {
-	kernel             *core.GameKernel
-	availableAbilities map[string]abilitiesentities.Ability
-	abilities          []string
+	kernel         *core.GameKernel
+	playingPlugins *core.PluginManager
+
+	availableAbilities []abilitiesentities.Ability
+	options            []option
 
 	selectionDelay float64
 }
 
-func NewChooseAbilityPlugin(plugins *core.PluginManager) *ChooseAbilityPlugin {
-	abilities := []string{
-		"BasicWeapon",
-		"DaggersWeapon",
-		"ProtectionWeapon",
-		"FireballWeapon",
-	}
-
-	abilitiesByName := map[string]abilitiesentities.Ability{
-		"BasicWeapon":      abilitiesentitiesbasic.New(),
-		"DaggersWeapon":    abilitiesentitiesdagger.New(),
-		"ProtectionWeapon": abilitiesentitiesprotection.New(),
-		"FireballWeapon":   abilitiesentitiesfireball.New(),
-		// "HealAbility":      abilitiesentitiesheal.New(),
+func NewChooseAbilityPlugin(playingPlugins *core.PluginManager) *ChooseAbilityPlugin {
+	abilities := []abilitiesentities.Ability{
+		abilitiesentitiesbasic.New(),
+		abilitiesentitiesdagger.New(),
+		abilitiesentitiesprotection.New(),
+		abilitiesentitiesfireball.New(),
+		// abilitiesentitiesheal.New(),
 	}
 
 	cp := ChooseAbilityPlugin{
-		availableAbilities: abilitiesByName,
-		abilities:          abilities,
+		playingPlugins:     playingPlugins,
+		availableAbilities: abilities,
 	}
 
 	return &cp
@@ -58,49 +68,104 @@ func (cp *ChooseAbilityPlugin) Init(kernel *core.GameKernel) error {
 }
 
 func (cp *ChooseAbilityPlugin) Update() error {
+	if len(cp.options) == 0 {
+		cp.options = cp.rollOptions()
+
+		// Everything is at max level, nothing to choose
+		if len(cp.options) == 0 {
+			cp.kernel.EventBus.Publish("ChoiceSkipped", nil)
+
+			return nil
+		}
+	}
+
 	cp.selectionDelay += cp.kernel.DeltaTime
 
 	if cp.selectionDelay > 1 {
-		if ebiten.IsKeyPressed(ebiten.Key1) {
-			cp.kernel.EventBus.Publish("NewAbility", cp.availableAbilities["BasicWeapon"])
-			cp.selectionDelay = 0
+		for i, key := range optionKeys {
+			if i < len(cp.options) && ebiten.IsKeyPressed(key) {
+				choose := cp.options[i].choose
 
-		} else if ebiten.IsKeyPressed(ebiten.Key2) {
-			cp.kernel.EventBus.Publish("NewAbility", cp.availableAbilities["DaggersWeapon"])
-			cp.selectionDelay = 0
+				cp.options = nil
+				cp.selectionDelay = 0
 
-		} else if ebiten.IsKeyPressed(ebiten.Key3) {
-			cp.kernel.EventBus.Publish("NewAbility", cp.availableAbilities["ProtectionWeapon"])
-			cp.selectionDelay = 0
+				choose()
 
-		} else if ebiten.IsKeyPressed(ebiten.Key4) {
-			cp.kernel.EventBus.Publish("NewAbility", cp.availableAbilities["FireballWeapon"])
-			cp.selectionDelay = 0
+				break
+			}
 		}
 	}
 
 	return nil
 }
 
-func (cp *ChooseAbilityPlugin) Draw(screen *ebiten.Image) {
-	text.Draw(screen, "Qual habilidade você quer?", fontface.FontFace, 300, 150, color.White)
+// rollOptions picks random abilities and passive items that are not at
+// their max level yet
+func (cp *ChooseAbilityPlugin) rollOptions() []option {
+	var options []option
 
-	for i, key := range cp.abilities {
-		col := color.White
+	abilityPlugin := cp.playingPlugins.GetPlugin("AbilitySystem").(*ability.AbilityPlugin)
 
-		name := ""
+	acquiredLevels := map[string]int{}
+	for _, a := range abilityPlugin.GetAcquiredAbilities() {
+		if a.MaxLevel() {
+			acquiredLevels[a.ID()] = -1
+		} else {
+			acquiredLevels[a.ID()] = a.CurrentLevel()
+		}
+	}
 
-		switch key {
-		case "BasicWeapon":
-			name = "1. Basic Weapon"
-		case "DaggersWeapon":
-			name = "2. Daggers Weapon"
-		case "ProtectionWeapon":
-			name = "3. Protection Weapon"
-		case "FireballWeapon":
-			name = "4. Fireball Weapon"
+	for _, a := range cp.availableAbilities {
+		currentLevel, acquired := acquiredLevels[a.ID()]
+		if currentLevel < 0 {
+			continue
 		}
 
-		text.Draw(screen, name, fontface.FontFace, 300, 200+(i*30), col)
+		nextLevel := 1
+		if acquired {
+			nextLevel = currentLevel + 1
+		}
+
+		a := a
+		options = append(options, option{
+			name:  a.ID(),
+			level: nextLevel,
+			choose: func() {
+				cp.kernel.EventBus.Publish("NewAbility", a)
+			},
+		})
+	}
+
+	passivePlugin := cp.playingPlugins.GetPlugin("PassiveSystem").(*passive.PassivePlugin)
+
+	for _, item := range passivePlugin.GetAvailableItems() {
+		item := item
+		options = append(options, option{
+			name:  fmt.Sprintf("%s (%s)", item.Name, item.Description),
+			level: item.Level + 1,
+			choose: func() {
+				cp.kernel.EventBus.Publish("NewPassiveItem", item.ID)
+			},
+		})
+	}
+
+	rand.Shuffle(len(options), func(i, j int) {
+		options[i], options[j] = options[j], options[i]
+	})
+
+	if len(options) > maxOptions {
+		options = options[:maxOptions]
+	}
+
+	return options
+}
+
+func (cp *ChooseAbilityPlugin) Draw(screen *ebiten.Image) {
+	text.Draw(screen, "Qual habilidade você quer?", fontface.FontFace, 300, 150, color.White)
+
+	for i, o := range cp.options {
+		name := fmt.Sprintf("%d. %s - Level %d", i+1, o.name, o.level)
+
+		text.Draw(screen, name, fontface.FontFace, 150, 200+(i*40), color.White)
 	}
 }
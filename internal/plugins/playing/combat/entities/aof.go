@@ -1,17 +1,53 @@
 package entities
 
+import "game/internal/helpers/shapes"
+
+const (
+	TargetEnemies = "enemies"
+	TargetPlayer  = "player"
+)
+
 // Area of effect
 type AOF interface {
 	Target() string
 	GetCollision(x, y float64) bool
+	Shape() shapes.Shape
 }
 
 type Square struct {
 	X, Y          float64
 	Width, Height float64
+
+	Targets string
 }
 
 type Radius struct {
 	X, Y   float64
 	Radius float64
+
+	Targets string
+}
+
+func (s Square) Target() string {
+	return s.Targets
+}
+
+func (s Square) GetCollision(x, y float64) bool {
+	return shapes.Contains(s.Shape(), x, y)
+}
+
+func (s Square) Shape() shapes.Shape {
+	return shapes.AABB{X: s.X, Y: s.Y, Width: s.Width, Height: s.Height}
+}
+
+func (r Radius) Target() string {
+	return r.Targets
+}
+
+func (r Radius) GetCollision(x, y float64) bool {
+	return shapes.Contains(r.Shape(), x, y)
+}
+
+func (r Radius) Shape() shapes.Shape {
+	return shapes.Circle{X: r.X, Y: r.Y, Radius: r.Radius}
 }
@@ -0,0 +1,48 @@
+package events
+
+import (
+	"game/internal/plugins/playing/damage"
+	enemyentities "game/internal/plugins/playing/enemy/entities"
+)
+
+// Names of the events published on the kernel event bus
+const (
+	// EnemyHit carries the applied DamageEvent
+	EnemyHit = "EnemyHit"
+
+	// EnemyKilled carries the DamageEvent that killed the enemy
+	EnemyKilled = "EnemyKilled"
+
+	// PlayerHurt carries a PlayerHurtEvent
+	PlayerHurt = "PlayerHurt"
+)
+
+// DamageEvent is a hit on an enemy flowing through the combat pipeline, X
+// and Y is where the hit comes from
+type DamageEvent struct {
+	// Source is the ability ID, or what else caused the hit
+	Source string
+	Target *enemyentities.Enemy
+
+	// Amount is the raw damage until the pipeline mitigates it by the target
+	// defenses, the published events carry the damage dealt
+	Amount   float64
+	Type     damage.Type
+	Critical bool
+
+	// Unblockable hits ignore the target defenses and never crit
+	Unblockable bool
+
+	// Knockback is the distance the target is pushed away from X, Y
+	Knockback float64
+
+	X, Y float64
+}
+
+// PlayerHurtEvent is published after a hit damaged the player
+type PlayerHurtEvent struct {
+	Source string
+	Amount float64
+
+	X, Y float64
+}
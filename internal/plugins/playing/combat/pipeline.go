@@ -0,0 +1,99 @@
+package combat
+
+import (
+	"game/internal/plugins"
+	"game/internal/plugins/playing/combat/events"
+	"game/internal/plugins/playing/damage"
+	"game/internal/plugins/playing/pickup"
+	"game/internal/plugins/playing/player/attributes"
+	"math/rand"
+
+	pickupentities "game/internal/plugins/playing/pickup/entities"
+)
+
+// Modifier changes a damage event before it's mitigated and applied,
+// returning false cancels the hit
+type Modifier func(event *events.DamageEvent) bool
+
+// AddModifier appends a modifier to the pipeline, modifiers run in the order
+// they were added
+func (cp *CombatPlugin) AddModifier(modifier Modifier) {
+	cp.modifiers = append(cp.modifiers, modifier)
+}
+
+// Deal runs the event through the modifiers, mitigates it by the target
+// defenses and applies it, publishing EnemyHit and EnemyKilled
+func (cp *CombatPlugin) Deal(event events.DamageEvent) {
+	for _, modifier := range cp.modifiers {
+		if !modifier(&event) {
+			return
+		}
+	}
+
+	enemy := event.Target
+
+	if !event.Unblockable {
+		template := enemy.Template
+		event.Amount = damage.Calculate(event.Amount, event.Type, template.Armor, template.Resistances)
+	}
+
+	if !enemy.Active || event.Amount <= 0 {
+		return
+	}
+
+	cp.enemyPlugin.ApplyDamage(enemy, event.Amount, event.Critical, event.Type)
+	enemy.DamageFlashTime = 0.1
+
+	if event.Knockback > 0 && enemy.Boss == nil {
+		cp.enemyPlugin.Knockback(enemy, event.X, event.Y, event.Knockback)
+	}
+
+	cp.kernel.EventBus.Publish(events.EnemyHit, event)
+
+	if enemy.Health <= 0 {
+		cp.killEnemy(event)
+	}
+}
+
+// critical multiplies the hits by the player critical multiplier on its
+// critical chance
+func critical(player plugins.PlayerPlugin) Modifier {
+	return func(event *events.DamageEvent) bool {
+		if !event.Unblockable && rand.Float64() < player.GetCriticalChance()/100 {
+			event.Amount *= player.GetAttribute(attributes.CriticalMultiplier)
+			event.Critical = true
+		}
+
+		return true
+	}
+}
+
+func (cp *CombatPlugin) killEnemy(event events.DamageEvent) {
+	enemy := event.Target
+	enemy.Active = false
+
+	pickupPlugin := cp.plugins.GetPlugin("PickupSystem").(*pickup.PickupPlugin)
+
+	cp.enemyPlugin.AddDeathEnemies(enemy)
+	pickupPlugin.DropGem(
+		enemy.X+(enemy.Width/2),
+		enemy.Y+(enemy.Height/2),
+		enemy.Experience())
+
+	// Loot falls next to the gem so both stay visible
+	if item, ok := enemy.Template.Loot.Roll(); ok {
+		pickupPlugin.Drop(
+			pickupentities.Kind(item),
+			enemy.X+(enemy.Width/2)+enemy.Width/4,
+			enemy.Y+(enemy.Height/2))
+	}
+
+	if enemy.Boss != nil {
+		pickupPlugin.Drop(
+			pickupentities.Chest,
+			enemy.X+(enemy.Width/2),
+			enemy.Y+(enemy.Height/2)+enemy.Height/4)
+	}
+
+	cp.kernel.EventBus.Publish(events.EnemyKilled, event)
+}
@@ -0,0 +1,96 @@
+package combat
+
+import (
+	"game/internal/core"
+	"game/internal/plugins/playing/combat/events"
+	"game/internal/plugins/playing/damage"
+	"game/internal/plugins/playing/enemy"
+	enemyentities "game/internal/plugins/playing/enemy/entities"
+	"testing"
+)
+
+// pipeline returns a combat plugin dealing damage to an armored enemy and the
+// EnemyHit events it publishes
+func pipeline(modifiers ...Modifier) (*CombatPlugin, *enemyentities.Enemy, *[]events.DamageEvent) {
+	cp := &CombatPlugin{
+		kernel:      core.NewGameKernel(),
+		enemyPlugin: enemy.NewEnemyPlugin(nil, nil),
+	}
+
+	for _, modifier := range modifiers {
+		cp.AddModifier(modifier)
+	}
+
+	hits := &[]events.DamageEvent{}
+
+	cp.kernel.EventBus.Subscribe(events.EnemyHit, func(data interface{}) {
+		*hits = append(*hits, data.(events.DamageEvent))
+	})
+
+	target := &enemyentities.Enemy{
+		Active:    true,
+		Health:    100,
+		MaxHealth: 100,
+		Template:  enemyentities.EnemyTemplate{Armor: 50},
+	}
+
+	return cp, target, hits
+}
+
+func TestDeal(t *testing.T) {
+	double := func(event *events.DamageEvent) bool {
+		event.Amount *= 2
+		return true
+	}
+
+	cancel := func(*events.DamageEvent) bool {
+		return false
+	}
+
+	tests := []struct {
+		name        string
+		modifiers   []Modifier
+		unblockable bool
+		want        float64
+	}{
+		{"armor", nil, false, 5},
+		{"modifier before the armor", []Modifier{double}, false, 10},
+		{"modifiers in order", []Modifier{double, double}, false, 20},
+		{"cancelled", []Modifier{double, cancel}, false, 0},
+		{"unblockable", nil, true, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cp, target, hits := pipeline(tt.modifiers...)
+
+			cp.Deal(events.DamageEvent{
+				Source:      "Test",
+				Target:      target,
+				Amount:      10,
+				Type:        damage.Physical,
+				Unblockable: tt.unblockable,
+			})
+
+			if dealt := target.MaxHealth - target.Health; dealt != tt.want {
+				t.Errorf("dealt %v damage, want %v", dealt, tt.want)
+			}
+
+			if tt.want == 0 {
+				if len(*hits) != 0 {
+					t.Errorf("cancelled hit published %v EnemyHit events", len(*hits))
+				}
+
+				return
+			}
+
+			if len(*hits) != 1 {
+				t.Fatalf("published %v EnemyHit events, want 1", len(*hits))
+			}
+
+			if event := (*hits)[0]; event.Amount != tt.want || event.Source != "Test" || event.Target != target {
+				t.Errorf("published %+v, want %v damage from Test to the target", event, tt.want)
+			}
+		})
+	}
+}
@@ -2,22 +2,42 @@ package combat
 
 import (
 	"game/internal/core"
-	"game/internal/helpers/collision"
+	"game/internal/core/eventbus"
+	"game/internal/helpers/shapes"
 	"game/internal/plugins"
 	"game/internal/plugins/playing/ability"
 	"game/internal/plugins/playing/camera"
+	"game/internal/plugins/playing/combat/entities"
+	"game/internal/plugins/playing/combat/events"
+	"game/internal/plugins/playing/damage"
 	"game/internal/plugins/playing/enemy"
-	"game/internal/plugins/playing/experience"
 
 	entitiesabilities "game/internal/plugins/playing/ability/entities/abilities"
+	enemyentities "game/internal/plugins/playing/enemy/entities"
+	playerentities "game/internal/plugins/playing/player/entities"
 
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
+// broadphaseMargin grows the collider bounds queried in the enemy grid, the
+// grid skips the enemies only touching the bounds
+const broadphaseMargin = 1
+
+// enemyIndex is the enemy lookup the broadphase runs on
+type enemyIndex interface {
+	EnemiesInRect(x, y, width, height float64) []*enemyentities.Enemy
+}
+
 type CombatPlugin struct {
 	kernel      *core.GameKernel
 	plugins     *core.PluginManager
 	enemyPlugin *enemy.EnemyPlugin
+
+	subscription eventbus.Subscription
+
+	shockwaves []playerentities.Shockwave
+
+	modifiers []Modifier
 }
 
 func NewCombatPlugin(
@@ -37,75 +57,108 @@ func (cp *CombatPlugin) ID() string {
 func (cp *CombatPlugin) Init(kernel *core.GameKernel) error {
 	cp.kernel = kernel
 
+	cp.AddModifier(critical(cp.plugins.GetPlugin("PlayerSystem").(plugins.PlayerPlugin)))
+
+	cp.subscription = kernel.EventBus.Subscribe("Shockwave", func(data interface{}) {
+		cp.shockwaves = append(cp.shockwaves, data.(playerentities.Shockwave))
+	})
+
 	return nil
 }
 
+// Close stops receiving the shockwaves once the run ends
+func (cp *CombatPlugin) Close() {
+	cp.kernel.EventBus.Unsubscribe(cp.subscription)
+}
+
 func (cp *CombatPlugin) Draw(*ebiten.Image) {
 }
 
 func (cp *CombatPlugin) Update() error {
 	wp := cp.plugins.GetPlugin("AbilitySystem").(*ability.AbilityPlugin)
-	ep := cp.plugins.GetPlugin("ExperienceSystem").(*experience.ExperiencePlugin)
 	pp := cp.plugins.GetPlugin("PlayerSystem").(plugins.PlayerPlugin)
 	cameraPlugin := cp.plugins.GetPlugin("CameraSystem").(*camera.CameraPlugin)
 
-	enemies := cp.enemyPlugin.GetEnemies()
-	playerX, playerY := pp.GetPosition()
-	playerWidth, playerHeight := pp.GetSize()
 	cameraX, cameraY := cameraPlugin.GetPosition()
 
-	for _, a := range wp.GetAcquiredAbilities() {
-		for _, enemy := range enemies {
-			combatOutput := a.Combat(entitiesabilities.CombatInput{
-				DeltaTime:    cp.kernel.DeltaTime,
-				Enemy:        enemy,
-				PlayerPlugin: pp,
-				EnemyPlugin:  cp.enemyPlugin,
-				CameraX:      cameraX,
-				CameraY:      cameraY,
-			})
-
-			if combatOutput.EnemyGotDamaged {
-				cp.enemyPlugin.ApplyDamage(
-					enemy,
-					combatOutput.Damage,
-					combatOutput.CriticalDamage)
-
-				enemy.DamageFlashTime = 0.1
-			}
+	for _, shockwave := range cp.shockwaves {
+		cp.applyShockwave(shockwave)
+	}
 
-			if enemy.Active {
-				if enemy.Health <= 0 {
-					enemy.Active = false
+	cp.shockwaves = cp.shockwaves[:0]
 
-					cp.enemyPlugin.AddDeathEnemies(enemy)
-					ep.DropCrystal(
-						enemy.X+(enemy.Width/2),
-						enemy.Y+(enemy.Height/2))
+	// Broadphase, every collider is only checked against the enemies the
+	// grid finds around its bounds
+	for _, a := range wp.GetAcquiredAbilities() {
+		for _, collider := range a.Colliders() {
+			for _, enemy := range touching(collider.Shape, cp.enemyPlugin) {
+				event, hit := collider.Hit(entitiesabilities.CombatInput{
+					DeltaTime:    cp.kernel.DeltaTime,
+					Enemy:        enemy,
+					PlayerPlugin: pp,
+					EnemyPlugin:  cp.enemyPlugin,
+					CameraX:      cameraX,
+					CameraY:      cameraY,
+				})
+
+				if hit {
+					cp.Deal(event)
 				}
 			}
 		}
+	}
 
-		// Update projectiles
-		for _, p := range cp.enemyPlugin.GetGlobalProjectiles() {
-			if p.Active {
-				p.X += p.DirectionX * p.Speed * cp.kernel.DeltaTime
-				p.Y += p.DirectionY * p.Speed * cp.kernel.DeltaTime
-
-				// Check collision with player
-				playerCollision := collision.Check(
-					p.X, p.Y,
-					p.Width, p.Height,
-					(playerX - playerWidth/2), (playerY - playerHeight/2),
-					playerWidth, playerHeight)
-
-				if playerCollision {
-					pp.ApplyDamage(p.Power)
-					p.Active = false
-				}
-			}
+	return nil
+}
+
+// touching returns the enemies whose hitbox intersects the shape, the grid
+// only returns the enemies inside the shape bounds, grown so the ones just
+// touching them are found too
+func touching(shape shapes.Shape, enemies enemyIndex) []*enemyentities.Enemy {
+	bounds := shape.Bounds()
+
+	candidates := enemies.EnemiesInRect(
+		bounds.X-broadphaseMargin,
+		bounds.Y-broadphaseMargin,
+		bounds.Width+broadphaseMargin*2,
+		bounds.Height+broadphaseMargin*2)
+
+	hits := candidates[:0]
+
+	for _, enemy := range candidates {
+		if shapes.Intersects(shape, enemy.Hitbox()) {
+			hits = append(hits, enemy)
 		}
 	}
 
-	return nil
+	return hits
+}
+
+// applyShockwave kills every enemy inside the shockwave radius, bosses are not
+// affected
+func (cp *CombatPlugin) applyShockwave(shockwave playerentities.Shockwave) {
+
+	area := entities.Radius{
+		X:       shockwave.X,
+		Y:       shockwave.Y,
+		Radius:  shockwave.Radius,
+		Targets: entities.TargetEnemies,
+	}
+
+	for _, enemy := range cp.enemyPlugin.EnemiesInRadius(shockwave.X, shockwave.Y, shockwave.Radius) {
+		if enemy.Active && enemy.Boss == nil && shapes.Intersects(area.Shape(), enemy.Hitbox()) {
+			// Shields break without counting in the damage dealt
+			enemy.Shield = 0
+
+			cp.Deal(events.DamageEvent{
+				Source:      "Shockwave",
+				Target:      enemy,
+				Amount:      enemy.Health,
+				Type:        damage.Arcane,
+				Unblockable: true,
+				X:           shockwave.X,
+				Y:           shockwave.Y,
+			})
+		}
+	}
 }
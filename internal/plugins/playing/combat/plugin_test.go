@@ -0,0 +1,172 @@
+package combat
+
+import (
+	"fmt"
+	"game/internal/helpers/shapes"
+	"game/internal/helpers/spatial"
+	"game/internal/helpers/spatial/spatialtest"
+	enemyentities "game/internal/plugins/playing/enemy/entities"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// gridIndex queries the enemies like the enemy plugin does
+type gridIndex struct {
+	grid *spatial.Grid[*enemyentities.Enemy]
+}
+
+func (g gridIndex) EnemiesInRect(x, y, width, height float64) []*enemyentities.Enemy {
+	return g.grid.QueryRect(x, y, width, height, nil)
+}
+
+// crowd returns count enemies sized from the small bats to the tanks
+func crowd(r *rand.Rand, count int, area float64) ([]*enemyentities.Enemy, gridIndex) {
+	enemies := make([]*enemyentities.Enemy, count)
+	grid := spatial.NewGrid[*enemyentities.Enemy](64)
+
+	for i, box := range spatialtest.Scatter(r, count, area, 24, 70) {
+		enemies[i] = &enemyentities.Enemy{
+			X:      box.X,
+			Y:      box.Y,
+			Width:  box.Width,
+			Height: box.Height,
+			Active: true,
+		}
+
+		grid.Insert(enemies[i], box.X, box.Y, box.Width, box.Height)
+	}
+
+	return enemies, gridIndex{grid}
+}
+
+// colliders returns count shapes like the abilities declare, from small
+// projectiles to wide cones and beams
+func colliders(r *rand.Rand, count int, area float64) []shapes.Shape {
+	result := make([]shapes.Shape, count)
+
+	for i := range result {
+		x := (r.Float64() - 0.5) * area
+		y := (r.Float64() - 0.5) * area
+		angle := r.Float64() * 2 * math.Pi
+
+		switch i % 5 {
+		case 0:
+			result[i] = shapes.AABB{X: x, Y: y, Width: 8 + r.Float64()*16, Height: 8 + r.Float64()*16}
+		case 1:
+			result[i] = shapes.Circle{X: x, Y: y, Radius: 8 + r.Float64()*120}
+		case 2:
+			result[i] = shapes.OrientedRect{X: x, Y: y, Width: 20 + r.Float64()*60, Height: 10, Angle: angle}
+		case 3:
+			result[i] = shapes.Cone{X: x, Y: y, Radius: 50 + r.Float64()*150, Angle: angle, Spread: r.Float64() * math.Pi}
+		default:
+			length := 100 + r.Float64()*300
+
+			result[i] = shapes.Capsule{
+				X1: x, Y1: y,
+				X2: x + math.Cos(angle)*length, Y2: y + math.Sin(angle)*length,
+				Radius: 4 + r.Float64()*12,
+			}
+		}
+	}
+
+	return result
+}
+
+func bruteForce(shape shapes.Shape, enemies []*enemyentities.Enemy) []*enemyentities.Enemy {
+	var hits []*enemyentities.Enemy
+
+	for _, enemy := range enemies {
+		if shapes.Intersects(shape, enemy.Hitbox()) {
+			hits = append(hits, enemy)
+		}
+	}
+
+	return hits
+}
+
+func TestTouchingMatchesBruteForce(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	enemies, index := crowd(r, 2000, 2000)
+
+	for i, shape := range colliders(r, 1000, 2200) {
+		got := touching(shape, index)
+		want := bruteForce(shape, enemies)
+
+		if !spatialtest.Same(got, want) {
+			t.Fatalf("collider %v %#v: broadphase found %v enemies, brute force %v", i, shape, len(got), len(want))
+		}
+	}
+}
+
+func TestTouchingFindsEnemiesOnTheBounds(t *testing.T) {
+	enemy := &enemyentities.Enemy{X: 100, Y: 0, Width: 40, Height: 40, Active: true}
+
+	grid := spatial.NewGrid[*enemyentities.Enemy](64)
+	grid.Insert(enemy, enemy.X, enemy.Y, enemy.Width, enemy.Height)
+
+	tests := []struct {
+		name  string
+		shape shapes.Shape
+		want  int
+	}{
+		{"box touching the left side", shapes.AABB{X: 60, Y: 0, Width: 40, Height: 40}, 1},
+		{"circle touching the top", shapes.Circle{X: 120, Y: -10, Radius: 10}, 1},
+		{"box short of the left side", shapes.AABB{X: 59, Y: 0, Width: 40, Height: 40}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := touching(tt.shape, gridIndex{grid}); len(got) != tt.want {
+				t.Errorf("touching found %v enemies, want %v", len(got), tt.want)
+			}
+		})
+	}
+}
+
+// BenchmarkBroadphase runs the lookup of every collider of a frame, like the
+// Update of the combat plugin without the hits
+func BenchmarkBroadphase(b *testing.B) {
+	for _, count := range spatialtest.Crowds {
+		for _, projectiles := range []int{200, 1000} {
+			b.Run(fmt.Sprintf("%d enemies %d colliders", count, projectiles), func(b *testing.B) {
+				r := rand.New(rand.NewPCG(1, 2))
+
+				_, index := crowd(r, count, spatialtest.Area)
+				targets := colliders(r, projectiles, spatialtest.Area)
+
+				b.ReportAllocs()
+				b.ResetTimer()
+
+				for i := 0; i < b.N; i++ {
+					for _, shape := range targets {
+						touching(shape, index)
+					}
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkBruteForce is the same frame checking every enemy, to compare with
+// the broadphase
+func BenchmarkBruteForce(b *testing.B) {
+	for _, count := range spatialtest.Crowds[:2] {
+		b.Run(fmt.Sprintf("%d enemies 200 colliders", count), func(b *testing.B) {
+			r := rand.New(rand.NewPCG(1, 2))
+
+			enemies, _ := crowd(r, count, spatialtest.Area)
+			targets := colliders(r, 200, spatialtest.Area)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				for _, shape := range targets {
+					bruteForce(shape, enemies)
+				}
+			}
+		})
+	}
+}
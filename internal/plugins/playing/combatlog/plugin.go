@@ -0,0 +1,213 @@
+package combatlog
+
+import (
+	"fmt"
+	"game/internal/core"
+	"game/internal/core/eventbus"
+	"game/internal/plugins/playing/combat/events"
+	"sort"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// dpsWindow is the seconds the live damage per second is averaged on
+const dpsWindow = 5
+
+// AbilityStats is what a damage source dealt during the run, the source is
+// the ability ID or what else hit the enemies
+type AbilityStats struct {
+	Source string
+	Damage float64
+	Hits   int
+	Crits  int
+	Kills  int
+
+	// DPS is the damage per second over the last seconds, Average over the
+	// whole run
+	DPS     float64
+	Average float64
+}
+
+// TakenStats is the damage taken by the player from an enemy type
+type TakenStats struct {
+	Source string
+	Damage float64
+	Hits   int
+}
+
+// Summary is the combat statistics of a run, sorted by damage
+type Summary struct {
+	Duration  float64
+	Abilities []AbilityStats
+	Taken     []TakenStats
+}
+
+// source keeps the damage of the last seconds in one bucket per second, so
+// the memory doesn't grow with the hits
+type source struct {
+	stats AbilityStats
+
+	buckets [dpsWindow]float64
+	seconds [dpsWindow]int
+}
+
+func (s *source) add(second int, amount float64) {
+	i := second % dpsWindow
+
+	if s.seconds[i] != second {
+		s.seconds[i] = second
+		s.buckets[i] = 0
+	}
+
+	s.buckets[i] += amount
+}
+
+func (s *source) recent(second int) float64 {
+	total := 0.0
+
+	for i, bucket := range s.buckets {
+		if second-s.seconds[i] < dpsWindow {
+			total += bucket
+		}
+	}
+
+	return total
+}
+
+// CombatLogPlugin collects the combat events of the run
+type CombatLogPlugin struct {
+	kernel *core.GameKernel
+
+	subscriptions []eventbus.Subscription
+
+	elapsed float64
+
+	dealt map[string]*source
+	taken map[string]*TakenStats
+}
+
+func NewCombatLogPlugin() *CombatLogPlugin {
+	return &CombatLogPlugin{
+		dealt: map[string]*source{},
+		taken: map[string]*TakenStats{},
+	}
+}
+
+func (cl *CombatLogPlugin) ID() string {
+	return "CombatLogSystem"
+}
+
+func (cl *CombatLogPlugin) Init(kernel *core.GameKernel) error {
+	cl.kernel = kernel
+
+	hit := kernel.EventBus.Subscribe(events.EnemyHit, func(data interface{}) {
+		event := data.(events.DamageEvent)
+
+		s := cl.sourceByName(event.Source)
+		s.stats.Damage += event.Amount
+		s.stats.Hits++
+
+		if event.Critical {
+			s.stats.Crits++
+		}
+
+		s.add(int(cl.elapsed), event.Amount)
+	})
+
+	killed := kernel.EventBus.Subscribe(events.EnemyKilled, func(data interface{}) {
+		cl.sourceByName(data.(events.DamageEvent).Source).stats.Kills++
+	})
+
+	hurt := kernel.EventBus.Subscribe(events.PlayerHurt, func(data interface{}) {
+		event := data.(events.PlayerHurtEvent)
+
+		stats, exists := cl.taken[event.Source]
+		if !exists {
+			stats = &TakenStats{Source: event.Source}
+			cl.taken[event.Source] = stats
+		}
+
+		stats.Damage += event.Amount
+		stats.Hits++
+	})
+
+	cl.subscriptions = []eventbus.Subscription{hit, killed, hurt}
+
+	return nil
+}
+
+// Close stops collecting, the summary of the run stays readable
+func (cl *CombatLogPlugin) Close() {
+	cl.kernel.EventBus.Unsubscribe(cl.subscriptions...)
+}
+
+func (cl *CombatLogPlugin) Update() error {
+	cl.elapsed += cl.kernel.DeltaTime
+
+	return nil
+}
+
+func (cl *CombatLogPlugin) Draw(*ebiten.Image) {
+}
+
+// Summary returns the statistics so far with the live DPS of every source
+func (cl *CombatLogPlugin) Summary() Summary {
+	second := int(cl.elapsed)
+
+	// The buckets cover the current second so far and the previous ones, the
+	// first seconds of the run average on the time played
+	window := min(cl.elapsed, cl.elapsed-float64(second-dpsWindow+1))
+
+	summary := Summary{Duration: cl.elapsed}
+
+	for _, s := range cl.dealt {
+		stats := s.stats
+
+		if window > 0 {
+			stats.DPS = s.recent(second) / window
+			stats.Average = stats.Damage / cl.elapsed
+		}
+
+		summary.Abilities = append(summary.Abilities, stats)
+	}
+
+	for _, stats := range cl.taken {
+		summary.Taken = append(summary.Taken, *stats)
+	}
+
+	sort.Slice(summary.Abilities, func(i, j int) bool {
+		return summary.Abilities[i].Damage > summary.Abilities[j].Damage
+	})
+
+	sort.Slice(summary.Taken, func(i, j int) bool {
+		return summary.Taken[i].Damage > summary.Taken[j].Damage
+	})
+
+	return summary
+}
+
+func (cl *CombatLogPlugin) sourceByName(name string) *source {
+	s, exists := cl.dealt[name]
+	if !exists {
+		s = &source{stats: AbilityStats{Source: name}}
+		cl.dealt[name] = s
+	}
+
+	return s
+}
+
+func (s AbilityStats) String() string {
+	return fmt.Sprintf("%-12s %8.0f dmg %7.1f dps %7.1f avg  hits %5d  crits %4d  kills %4d",
+		s.Source, s.Damage, s.DPS, s.Average, s.Hits, s.Crits, s.Kills)
+}
+
+func (t TakenStats) String() string {
+	return fmt.Sprintf("%-12s %8.0f dmg  hits %5d", t.Source, t.Damage, t.Hits)
+}
+
+// FormatDuration formats the run duration as minutes and seconds
+func FormatDuration(seconds float64) string {
+	total := int(seconds)
+
+	return fmt.Sprintf("%02d:%02d", total/60, total%60)
+}
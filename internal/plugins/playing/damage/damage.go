@@ -0,0 +1,62 @@
+package damage
+
+import (
+	"image/color"
+	"math"
+	"sort"
+)
+
+type Type string
+
+const (
+	Physical Type = "physical"
+	Fire     Type = "fire"
+	Frost    Type = "frost"
+	Arcane   Type = "arcane"
+)
+
+const (
+	// maxArmor is the armor cap, matching the player one
+	maxArmor = 90.0
+
+	// maxResistance keeps every type able to hurt, negative resistances
+	// are weaknesses and have no cap
+	maxResistance = 0.9
+)
+
+// Colors are used by the damage numbers
+var Colors = map[Type]color.RGBA{
+	Physical: {255, 255, 255, 200},
+	Fire:     {255, 140, 30, 200},
+	Frost:    {120, 200, 255, 200},
+	Arcane:   {200, 110, 255, 200},
+}
+
+func Exists(t Type) bool {
+	_, exists := Colors[t]
+
+	return exists
+}
+
+// Names returns the known damage types in a stable order
+func Names() []string {
+	names := make([]string, 0, len(Colors))
+
+	for t := range Colors {
+		names = append(names, string(t))
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Calculate mitigates the damage by the target defenses, armor only
+// reduces physical damage while resistances apply to their own type
+func Calculate(amount float64, t Type, armor float64, resistances map[Type]float64) float64 {
+	if t == Physical {
+		amount *= 1 - math.Min(armor, maxArmor)/100
+	}
+
+	return amount * (1 - math.Min(resistances[t], maxResistance))
+}
@@ -0,0 +1,165 @@
+package affixes
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"game/internal/assets"
+	"game/internal/plugins/playing/enemy/entities"
+	"image/color"
+	"path"
+	"sort"
+	"strings"
+)
+
+const definitionsPath = "assets/data/affixes"
+
+// Affixes are loaded from the embedded affix definitions by Load, keyed by
+// name
+var Affixes = map[string]*entities.Affix{}
+
+type definition struct {
+	Name    string `json:"name"`
+	Outline [3]int `json:"outline"`
+
+	HealthBonus float64 `json:"healthBonus"`
+	SpeedBonus  float64 `json:"speedBonus"`
+	DamageBonus float64 `json:"damageBonus"`
+
+	Shield       float64 `json:"shield"`
+	Regeneration float64 `json:"regeneration"`
+	Lifesteal    float64 `json:"lifesteal"`
+
+	Explosion entities.Explosion `json:"explosion"`
+	Split     entities.Split     `json:"split"`
+
+	ExperienceBonus float64 `json:"experienceBonus"`
+}
+
+// Load reads every affix definition, validating all of them before failing
+func Load() error {
+	entries, err := assets.ReadDir(definitionsPath)
+	if err != nil {
+		return fmt.Errorf("enemy affixes: %w", err)
+	}
+
+	var errs []error
+
+	loaded := map[string]*entities.Affix{}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+
+		file := path.Join(definitionsPath, entry.Name())
+
+		affix, err := loadAffix(file)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", file, err))
+			continue
+		}
+
+		if _, exists := loaded[affix.Name]; exists {
+			errs = append(errs, fmt.Errorf("%s: duplicated affix %q", file, affix.Name))
+			continue
+		}
+
+		loaded[affix.Name] = affix
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("enemy affixes:\n%w", errors.Join(errs...))
+	}
+
+	Affixes = loaded
+
+	return nil
+}
+
+// Names returns the loaded affix names in a stable order
+func Names() []string {
+	names := make([]string, 0, len(Affixes))
+
+	for name := range Affixes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func loadAffix(file string) (*entities.Affix, error) {
+	data, err := assets.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var d definition
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&d); err != nil {
+		return nil, err
+	}
+
+	if err := d.validate(); err != nil {
+		return nil, err
+	}
+
+	return &entities.Affix{
+		Name: d.Name,
+		Outline: color.RGBA{
+			uint8(d.Outline[0]), uint8(d.Outline[1]), uint8(d.Outline[2]), 255,
+		},
+		HealthBonus:     d.HealthBonus,
+		SpeedBonus:      d.SpeedBonus,
+		DamageBonus:     d.DamageBonus,
+		Shield:          d.Shield,
+		Regeneration:    d.Regeneration,
+		Lifesteal:       d.Lifesteal,
+		Explosion:       d.Explosion,
+		Split:           d.Split,
+		ExperienceBonus: d.ExperienceBonus,
+	}, nil
+}
+
+func (d definition) validate() error {
+	var errs []error
+
+	if d.Name == "" {
+		errs = append(errs, errors.New("name is required"))
+	}
+
+	for _, c := range d.Outline {
+		if c < 0 || c > 255 {
+			errs = append(errs, errors.New("outline components must be between 0 and 255"))
+			break
+		}
+	}
+
+	if d.HealthBonus <= -1 || d.SpeedBonus <= -1 || d.DamageBonus <= -1 {
+		errs = append(errs, errors.New("stat bonuses must be greater than -1"))
+	}
+
+	if d.Shield < 0 || d.Regeneration < 0 || d.Lifesteal < 0 || d.ExperienceBonus < 0 {
+		errs = append(errs, errors.New("shield, regeneration, lifesteal and experienceBonus can't be negative"))
+	}
+
+	if d.Explosion.Radius < 0 || d.Explosion.Damage < 0 {
+		errs = append(errs, errors.New("explosion radius and damage can't be negative"))
+	}
+
+	if d.Split.Count < 0 {
+		errs = append(errs, errors.New("split count can't be negative"))
+	}
+
+	if d.Split.Count > 0 && (d.Split.Health <= 0 || d.Split.Health > 1) {
+		errs = append(errs, errors.New("split health must be between 0 and 1"))
+	}
+
+	return errors.Join(errs...)
+}
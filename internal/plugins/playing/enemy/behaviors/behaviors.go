@@ -0,0 +1,105 @@
+package behaviors
+
+import (
+	"game/internal/plugins/playing/enemy/entities"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// pathMinDistance is the distance to the player below which enemies walk
+// straight instead of following the flow field
+const pathMinDistance = 64.0
+
+// Factory creates a new behavior instance for an enemy of the template
+type Factory func(template *entities.EnemyTemplate) entities.Behavior
+
+var registry = map[string]Factory{
+	"chase":   func(*entities.EnemyTemplate) entities.Behavior { return &Chase{} },
+	"kite":    func(*entities.EnemyTemplate) entities.Behavior { return &Kite{} },
+	"charger": func(*entities.EnemyTemplate) entities.Behavior { return &Charger{} },
+	"orbiter": func(*entities.EnemyTemplate) entities.Behavior { return &Orbiter{Clockwise: rand.Intn(2) == 0} },
+	"zigzag":  func(*entities.EnemyTemplate) entities.Behavior { return &ZigZag{} },
+	"turret":  func(*entities.EnemyTemplate) entities.Behavior { return &Turret{} },
+	"swarm":   func(*entities.EnemyTemplate) entities.Behavior { return &Swarm{} },
+}
+
+// Register adds a behavior that enemy definitions can reference by name
+func Register(name string, factory Factory) {
+	registry[name] = factory
+}
+
+func Exists(name string) bool {
+	_, exists := registry[name]
+
+	return exists
+}
+
+// Names returns the registered behaviors in a stable order
+func Names() []string {
+	names := make([]string, 0, len(registry))
+
+	for name := range registry {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// New creates the behavior of a template, unknown behaviors fall back to
+// chase, templates are validated when loaded
+func New(template *entities.EnemyTemplate) entities.Behavior {
+	factory, exists := registry[template.Behavior]
+	if !exists {
+		return &Chase{}
+	}
+
+	return factory(template)
+}
+
+// toPlayer returns the normalized direction and distance to the player
+func toPlayer(enemy *entities.Enemy, ctx *entities.BehaviorContext) (float64, float64, float64) {
+	dx := ctx.PlayerX - enemy.X
+	dy := ctx.PlayerY - enemy.Y
+	distance := math.Sqrt(dx*dx + dy*dy)
+
+	if distance > 0 {
+		dx /= distance
+		dy /= distance
+	}
+
+	return dx, dy, distance
+}
+
+// towardPlayer returns the normalized direction to walk toward the player,
+// following the flow field around obstacles when the player is not close
+func towardPlayer(enemy *entities.Enemy, ctx *entities.BehaviorContext) (float64, float64, float64) {
+	dx, dy, distance := toPlayer(enemy, ctx)
+
+	if distance < pathMinDistance || ctx.PathToPlayer == nil {
+		return dx, dy, distance
+	}
+
+	if px, py, ok := ctx.PathToPlayer(enemy.X+enemy.Width/2, enemy.Y+enemy.Height/2); ok {
+		return px, py, distance
+	}
+
+	return dx, dy, distance
+}
+
+// attack shoots at the player when in range and the cooldown is over
+func attack(enemy *entities.Enemy, ctx *entities.BehaviorContext, distance float64) {
+	if enemy.AttackRange <= 0 || distance > enemy.AttackRange {
+		return
+	}
+
+	enemy.AttackCooldown -= ctx.DeltaTime
+
+	if enemy.AttackCooldown <= 0 {
+		ctx.Shoot(enemy, ctx.PlayerX, ctx.PlayerY)
+
+		enemy.AttackCooldown = enemy.Template.AttackCooldown
+	}
+}
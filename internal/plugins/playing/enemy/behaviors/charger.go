@@ -0,0 +1,69 @@
+package behaviors
+
+import "game/internal/plugins/playing/enemy/entities"
+
+const (
+	chargerWindup   = 0.6
+	chargerDuration = 0.5
+	chargerRecovery = 1.0
+	chargerSpeed    = 4.0
+)
+
+type chargerState int
+
+const (
+	chargerApproaching chargerState = iota
+	chargerWindingUp
+	chargerCharging
+	chargerRecovering
+)
+
+// Charger approaches until the player is in range, stops to wind up and
+// then charges in a straight line toward where the player was
+type Charger struct {
+	state      chargerState
+	timer      float64
+	directionX float64
+	directionY float64
+}
+
+func (c *Charger) Update(enemy *entities.Enemy, ctx *entities.BehaviorContext) entities.Movement {
+	dx, dy, distance := towardPlayer(enemy, ctx)
+
+	c.timer -= ctx.DeltaTime
+
+	switch c.state {
+	case chargerApproaching:
+		if distance <= enemy.AttackRange {
+			c.state = chargerWindingUp
+			c.timer = chargerWindup
+		}
+
+		return entities.Movement{X: dx, Y: dy, Speed: 1}
+
+	case chargerWindingUp:
+		if c.timer <= 0 {
+			c.state = chargerCharging
+			c.timer = chargerDuration
+			// Charges go in a straight line, even through obstacles in the way
+			c.directionX, c.directionY, _ = toPlayer(enemy, ctx)
+		}
+
+		return entities.Movement{}
+
+	case chargerCharging:
+		if c.timer <= 0 {
+			c.state = chargerRecovering
+			c.timer = chargerRecovery
+		}
+
+		return entities.Movement{X: c.directionX, Y: c.directionY, Speed: chargerSpeed}
+
+	default:
+		if c.timer <= 0 {
+			c.state = chargerApproaching
+		}
+
+		return entities.Movement{X: dx, Y: dy, Speed: 0.3}
+	}
+}
@@ -0,0 +1,12 @@
+package behaviors
+
+import "game/internal/plugins/playing/enemy/entities"
+
+// Chase walks straight toward the player
+type Chase struct{}
+
+func (c *Chase) Update(enemy *entities.Enemy, ctx *entities.BehaviorContext) entities.Movement {
+	dx, dy, _ := towardPlayer(enemy, ctx)
+
+	return entities.Movement{X: dx, Y: dy, Speed: 1}
+}
@@ -0,0 +1,23 @@
+package behaviors
+
+import "game/internal/plugins/playing/enemy/entities"
+
+// kiteDistance is the distance kiting enemies try to keep from the player
+const kiteDistance = 200.0
+
+// Kite keeps its distance from the player and shoots when in range
+type Kite struct{}
+
+func (k *Kite) Update(enemy *entities.Enemy, ctx *entities.BehaviorContext) entities.Movement {
+	dx, dy, distance := toPlayer(enemy, ctx)
+
+	attack(enemy, ctx, distance)
+
+	// Too close, move away from the player
+	if distance < kiteDistance {
+		dx = -dx
+		dy = -dy
+	}
+
+	return entities.Movement{X: dx, Y: dy, Speed: 1}
+}
@@ -0,0 +1,40 @@
+package behaviors
+
+import "game/internal/plugins/playing/enemy/entities"
+
+const (
+	orbiterRadius    = 180.0
+	orbiterTolerance = 20.0
+)
+
+// Orbiter circles the player at a fixed distance, shooting when it has an
+// attack range
+type Orbiter struct {
+	Clockwise bool
+}
+
+func (o *Orbiter) Update(enemy *entities.Enemy, ctx *entities.BehaviorContext) entities.Movement {
+	dx, dy, distance := toPlayer(enemy, ctx)
+
+	attack(enemy, ctx, distance)
+
+	// Tangent to the circle around the player
+	tx, ty := -dy, dx
+	if o.Clockwise {
+		tx, ty = dy, -dx
+	}
+
+	// Radial correction to keep the orbit radius
+	radial := 0.0
+	if distance > orbiterRadius+orbiterTolerance {
+		radial = 1
+	} else if distance < orbiterRadius-orbiterTolerance {
+		radial = -1
+	}
+
+	return entities.Movement{
+		X:     tx + dx*radial,
+		Y:     ty + dy*radial,
+		Speed: 1,
+	}
+}
@@ -0,0 +1,65 @@
+package behaviors
+
+import (
+	"game/internal/plugins/playing/enemy/entities"
+	"math"
+)
+
+const (
+	swarmRadius    = 120.0
+	swarmCohesion  = 0.4
+	swarmAlignment = 0.3
+)
+
+// Swarm follows the other enemies of the same type around it, drifting
+// toward the player as a group
+type Swarm struct{}
+
+func (s *Swarm) Update(enemy *entities.Enemy, ctx *entities.BehaviorContext) entities.Movement {
+	dx, dy, _ := towardPlayer(enemy, ctx)
+
+	centerX, centerY := 0.0, 0.0
+	headingX, headingY := 0.0, 0.0
+	neighbors := 0
+
+	for _, other := range ctx.Nearby(enemy.X, enemy.Y, swarmRadius) {
+		if other == enemy || other.Type != enemy.Type {
+			continue
+		}
+
+		diffX := other.X - enemy.X
+		diffY := other.Y - enemy.Y
+
+		if diffX*diffX+diffY*diffY > swarmRadius*swarmRadius {
+			continue
+		}
+
+		centerX += diffX
+		centerY += diffY
+		headingX += other.VelocityX
+		headingY += other.VelocityY
+		neighbors++
+	}
+
+	if neighbors == 0 {
+		return entities.Movement{X: dx, Y: dy, Speed: 1}
+	}
+
+	centerX, centerY = normalize(centerX, centerY)
+	headingX, headingY = normalize(headingX, headingY)
+
+	x, y := normalize(
+		dx+centerX*swarmCohesion+headingX*swarmAlignment,
+		dy+centerY*swarmCohesion+headingY*swarmAlignment)
+
+	return entities.Movement{X: x, Y: y, Speed: 1}
+}
+
+func normalize(x, y float64) (float64, float64) {
+	length := math.Sqrt(x*x + y*y)
+	if length == 0 {
+		return 0, 0
+	}
+
+	return x / length, y / length
+}
@@ -0,0 +1,14 @@
+package behaviors
+
+import "game/internal/plugins/playing/enemy/entities"
+
+// Turret never moves and shoots at the player when in range
+type Turret struct{}
+
+func (t *Turret) Update(enemy *entities.Enemy, ctx *entities.BehaviorContext) entities.Movement {
+	_, _, distance := toPlayer(enemy, ctx)
+
+	attack(enemy, ctx, distance)
+
+	return entities.Movement{}
+}
@@ -0,0 +1,30 @@
+package behaviors
+
+import (
+	"game/internal/plugins/playing/enemy/entities"
+	"math"
+)
+
+const (
+	zigZagFrequency = 3.0
+	zigZagAmplitude = 0.8
+)
+
+// ZigZag moves toward the player swaying from side to side
+type ZigZag struct {
+	time float64
+}
+
+func (z *ZigZag) Update(enemy *entities.Enemy, ctx *entities.BehaviorContext) entities.Movement {
+	z.time += ctx.DeltaTime
+
+	dx, dy, _ := towardPlayer(enemy, ctx)
+
+	sway := math.Sin(z.time*zigZagFrequency) * zigZagAmplitude
+
+	return entities.Movement{
+		X:     dx - dy*sway,
+		Y:     dy + dx*sway,
+		Speed: 1,
+	}
+}
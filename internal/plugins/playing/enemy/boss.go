@@ -0,0 +1,79 @@
+package enemy
+
+import (
+	entity "game/internal/plugins/playing/enemy/entities"
+	"math"
+)
+
+// updateBoss moves the boss, changes its phase by the remaining health and
+// runs the attacks of the current phase
+func (ep *EnemyPlugin) updateBoss(enemy *entity.Enemy, ctx *entity.BehaviorContext) {
+	definition := enemy.Template.Boss
+	state := enemy.Boss
+
+	previous := state.CurrentPhase(definition).Speed
+	if state.NextPhase(definition, enemy.Health/enemy.MaxHealth) {
+		enemy.Speed *= state.CurrentPhase(definition).Speed / previous
+	}
+
+	if state.IsCharging() {
+		state.ChargeTimer -= ep.kernel.DeltaTime
+
+		ep.moveBy(enemy,
+			state.ChargeDirectionX*state.ChargeSpeed*ep.kernel.DeltaTime,
+			state.ChargeDirectionY*state.ChargeSpeed*ep.kernel.DeltaTime)
+	} else {
+		ep.moveEnemy(enemy, ctx)
+	}
+
+	for i, attack := range state.CurrentPhase(definition).Attacks {
+		state.AttackTimers[i] -= ep.kernel.DeltaTime
+		if state.AttackTimers[i] > 0 {
+			continue
+		}
+
+		state.AttackTimers[i] = attack.Cooldown
+
+		switch attack.Pattern {
+		case entity.BossAttackCharge:
+			ep.bossCharge(enemy, attack, ctx.PlayerX, ctx.PlayerY)
+		case entity.BossAttackShoot:
+			ep.emit(enemy, attack.ProjectileDefinition, attack.Emission, ctx.PlayerX, ctx.PlayerY)
+		case entity.BossAttackSummon:
+			ep.bossSummon(enemy, attack)
+		}
+	}
+}
+
+// bossCharge dashes toward where the player is when the charge starts
+func (ep *EnemyPlugin) bossCharge(enemy *entity.Enemy, attack entity.BossAttack, playerX, playerY float64) {
+	dx := playerX - (enemy.X + enemy.Width/2)
+	dy := playerY - (enemy.Y + enemy.Height/2)
+	distance := math.Sqrt(dx*dx + dy*dy)
+
+	if distance == 0 {
+		return
+	}
+
+	enemy.Boss.ChargeTimer = attack.Duration
+	enemy.Boss.ChargeSpeed = attack.Speed
+	enemy.Boss.ChargeDirectionX = dx / distance
+	enemy.Boss.ChargeDirectionY = dy / distance
+}
+
+// bossSummon places Count enemies around the boss
+func (ep *EnemyPlugin) bossSummon(enemy *entity.Enemy, attack entity.BossAttack) {
+	centerX := enemy.X + enemy.Width/2
+	centerY := enemy.Y + enemy.Height/2
+	radius := enemy.Width
+
+	for i := 0; i < attack.Count; i++ {
+		angle := 2 * math.Pi * float64(i) / float64(attack.Count)
+
+		ep.pending = append(ep.pending, pendingSpawn{
+			request: ep.director.Request(attack.Summon),
+			x:       centerX + math.Cos(angle)*radius,
+			y:       centerY + math.Sin(angle)*radius,
+		})
+	}
+}
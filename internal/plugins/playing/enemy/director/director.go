@@ -0,0 +1,199 @@
+package director
+
+import (
+	"game/internal/plugins/playing/enemy/affixes"
+	"game/internal/plugins/playing/enemy/entities"
+	"game/internal/plugins/playing/enemy/templates"
+	"math/rand"
+)
+
+// SpawnRequest is an enemy the director wants on the field
+type SpawnRequest struct {
+	Type entities.EnemyType
+
+	// Stat multipliers
+	Health float64
+	Damage float64
+	Speed  float64
+
+	// Affixes rolled for elite enemies
+	Affixes []string
+}
+
+// Group is a set of requests spawned together, Formation is empty when they
+// are placed independently
+type Group struct {
+	Formation string
+	Requests  []SpawnRequest
+}
+
+// Director follows a timeline, deciding when and what to spawn
+type Director struct {
+	timeline *Timeline
+
+	time       float64
+	spawnTimer float64
+
+	firedEvents map[*Event]bool
+}
+
+func New(timeline *Timeline) *Director {
+	return &Director{
+		timeline:    timeline,
+		firedEvents: make(map[*Event]bool),
+	}
+}
+
+// Update advances the run time and returns the enemies to spawn this frame,
+// respecting the current window cap on simultaneous enemies
+func (d *Director) Update(deltaTime float64, activeEnemies int) []Group {
+	d.time += deltaTime
+	d.spawnTimer += deltaTime
+
+	window := d.Window()
+	if window == nil {
+		return nil
+	}
+
+	var groups []Group
+
+	for i := range window.Events {
+		event := &window.Events[i]
+
+		if d.time >= event.At && !d.firedEvents[event] {
+			d.firedEvents[event] = true
+
+			groups = append(groups, Group{
+				Formation: event.Formation,
+				Requests:  d.eventRequests(window, event),
+			})
+		}
+	}
+
+	if d.spawnTimer >= window.SpawnInterval {
+		d.spawnTimer = 0
+
+		if activeEnemies < window.MaxEnemies {
+			groups = append(groups, Group{
+				Requests: []SpawnRequest{d.request(window, d.pick(window))},
+			})
+		}
+	}
+
+	return groups
+}
+
+func (d *Director) eventRequests(window *Window, event *Event) []SpawnRequest {
+	var requests []SpawnRequest
+
+	switch event.Kind {
+	case EventBurst, EventBoss:
+		for i := 0; i < event.Count; i++ {
+			requests = append(requests, d.request(window, event.Type))
+		}
+	}
+
+	return requests
+}
+
+// Pick returns a random enemy request from the current window
+func (d *Director) Pick() (SpawnRequest, bool) {
+	window := d.Window()
+	if window == nil {
+		return SpawnRequest{}, false
+	}
+
+	return d.request(window, d.pick(window)), true
+}
+
+// Request returns a request for the given enemy type using the current
+// window multipliers
+func (d *Director) Request(enemyType entities.EnemyType) SpawnRequest {
+	window := d.Window()
+	if window == nil {
+		return SpawnRequest{Type: enemyType, Health: 1, Damage: 1, Speed: 1}
+	}
+
+	return d.request(window, enemyType)
+}
+
+func (d *Director) request(window *Window, enemyType entities.EnemyType) SpawnRequest {
+	request := SpawnRequest{
+		Type:   enemyType,
+		Health: window.Health,
+		Damage: window.Damage,
+		Speed:  window.Speed,
+	}
+
+	if templates.EnemyTemplates[enemyType].Boss == nil && rand.Float64() < window.EliteChance {
+		request.Affixes = rollAffixes(window.EliteAffixes)
+	}
+
+	return request
+}
+
+// rollAffixes picks between one and max distinct affixes
+func rollAffixes(max int) []string {
+	names := affixes.Names()
+	rand.Shuffle(len(names), func(i, j int) {
+		names[i], names[j] = names[j], names[i]
+	})
+
+	count := 1 + rand.Intn(max)
+	if count > len(names) {
+		count = len(names)
+	}
+
+	return names[:count]
+}
+
+// pick chooses an enemy type by the window spawn weights
+func (d *Director) pick(window *Window) entities.EnemyType {
+	total := 0.0
+	for _, s := range window.Spawns {
+		total += s.Weight
+	}
+
+	r := rand.Float64() * total
+
+	for _, s := range window.Spawns {
+		r -= s.Weight
+		if r < 0 {
+			return s.Type
+		}
+	}
+
+	return window.Spawns[len(window.Spawns)-1].Type
+}
+
+// Window returns the latest window that already started, the last window
+// is kept after it ends
+func (d *Director) Window() *Window {
+	windows := d.timeline.Windows
+	if len(windows) == 0 {
+		return nil
+	}
+
+	current := &windows[0]
+
+	for i := range windows {
+		if d.time >= windows[i].Start {
+			current = &windows[i]
+		}
+	}
+
+	return current
+}
+
+// SpawnRing returns where the timeline wants the enemies to appear
+func (d *Director) SpawnRing() SpawnRing {
+	if d.timeline.SpawnRing != nil {
+		return *d.timeline.SpawnRing
+	}
+
+	return DefaultSpawnRing
+}
+
+func (d *Director) Time() float64 {
+	return d.time
+}
@@ -0,0 +1,289 @@
+package director
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"game/internal/assets"
+	"game/internal/plugins/playing/enemy/affixes"
+	"game/internal/plugins/playing/enemy/entities"
+	"game/internal/plugins/playing/enemy/templates"
+	"path"
+	"strings"
+)
+
+const timelinesPath = "assets/data/waves"
+
+// Timelines are loaded from the embedded wave files by Load, keyed by name
+var Timelines = map[string]*Timeline{}
+
+// Timeline describes what spawns during a run, one file per stage or
+// difficulty
+type Timeline struct {
+	Name    string   `json:"name"`
+	Windows []Window `json:"windows"`
+
+	// SpawnRing is optional, DefaultSpawnRing is used when it's missing
+	SpawnRing *SpawnRing `json:"spawnRing"`
+}
+
+// SpawnRing is the band around the camera view where enemies appear,
+// starting Margin pixels outside the view and Depth pixels wide
+type SpawnRing struct {
+	Margin float64 `json:"margin"`
+	Depth  float64 `json:"depth"`
+
+	// TravelBias is the chance of spawning ahead of the player movement
+	TravelBias float64 `json:"travelBias"`
+}
+
+// CullMargin is how far beyond the camera view enemies and projectiles are
+// removed, the spawn ring stays inside it
+const CullMargin = 200
+
+var DefaultSpawnRing = SpawnRing{
+	Margin:     48,
+	Depth:      96,
+	TravelBias: 0.5,
+}
+
+// Window is a time range of the run, End 0 means it never ends
+type Window struct {
+	Start float64 `json:"start"`
+	End   float64 `json:"end"`
+
+	SpawnInterval float64 `json:"spawnInterval"`
+	MaxEnemies    int     `json:"maxEnemies"`
+
+	Spawns []Spawn `json:"spawns"`
+
+	// Stat multipliers for the enemies spawned in this window
+	Health float64 `json:"health"`
+	Damage float64 `json:"damage"`
+	Speed  float64 `json:"speed"`
+
+	// EliteChance is the chance of a spawned enemy rolling between one and
+	// EliteAffixes affixes
+	EliteChance  float64 `json:"eliteChance"`
+	EliteAffixes int     `json:"eliteAffixes"`
+
+	Events []Event `json:"events"`
+}
+
+type Spawn struct {
+	Type   entities.EnemyType `json:"type"`
+	Weight float64            `json:"weight"`
+}
+
+// Event fires once at an absolute time of the run
+type Event struct {
+	At    float64            `json:"at"`
+	Kind  string             `json:"kind"`
+	Type  entities.EnemyType `json:"type"`
+	Count int                `json:"count"`
+
+	// Formation places the enemies of the event together, empty scatters
+	// them around the spawn ring
+	Formation string `json:"formation"`
+}
+
+const (
+	// EventBurst spawns Count enemies at once, ignoring the window cap
+	EventBurst = "burst"
+
+	// EventBoss spawns Count boss enemies, their type must have boss phases
+	EventBoss = "boss"
+)
+
+var eventKinds = map[string]bool{
+	EventBurst: true,
+	EventBoss:  true,
+}
+
+const (
+	// FormationRing encircles the player just outside the view
+	FormationRing = "ring"
+
+	// FormationLine is a wall of enemies outside the view, ahead of the
+	// player when it's moving
+	FormationLine = "line"
+)
+
+var formations = map[string]bool{
+	"":            true,
+	FormationRing: true,
+	FormationLine: true,
+}
+
+// Load reads and validates every timeline, enemy templates and affixes must
+// be loaded first so they can be checked
+func Load() error {
+	entries, err := assets.ReadDir(timelinesPath)
+	if err != nil {
+		return fmt.Errorf("wave timelines: %w", err)
+	}
+
+	var errs []error
+
+	timelines := map[string]*Timeline{}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+
+		file := path.Join(timelinesPath, entry.Name())
+
+		timeline, err := loadTimeline(file)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", file, err))
+			continue
+		}
+
+		if _, exists := timelines[timeline.Name]; exists {
+			errs = append(errs, fmt.Errorf("%s: duplicated timeline %q", file, timeline.Name))
+			continue
+		}
+
+		timelines[timeline.Name] = timeline
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("wave timelines:\n%w", errors.Join(errs...))
+	}
+
+	Timelines = timelines
+
+	return nil
+}
+
+func loadTimeline(file string) (*Timeline, error) {
+	data, err := assets.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var t Timeline
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&t); err != nil {
+		return nil, err
+	}
+
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func (t *Timeline) validate() error {
+	var errs []error
+
+	if t.Name == "" {
+		errs = append(errs, errors.New("name is required"))
+	}
+
+	if len(t.Windows) == 0 {
+		errs = append(errs, errors.New("at least one window is required"))
+	}
+
+	if ring := t.SpawnRing; ring != nil {
+		if ring.Margin < 0 || ring.Depth <= 0 {
+			errs = append(errs, errors.New("spawnRing margin can't be negative and depth must be positive"))
+		}
+
+		if ring.Margin+ring.Depth >= CullMargin {
+			errs = append(errs, fmt.Errorf("spawnRing margin plus depth must be under the %d pixels cull margin", CullMargin))
+		}
+
+		if ring.TravelBias < 0 || ring.TravelBias > 1 {
+			errs = append(errs, errors.New("spawnRing travelBias must be between 0 and 1"))
+		}
+	}
+
+	for i, w := range t.Windows {
+		prefix := fmt.Sprintf("window %d", i)
+
+		if i > 0 && w.Start < t.Windows[i-1].End {
+			errs = append(errs, fmt.Errorf("%s starts before the previous window ends", prefix))
+		}
+
+		if w.End != 0 && w.End <= w.Start {
+			errs = append(errs, fmt.Errorf("%s ends before it starts", prefix))
+		}
+
+		if w.End == 0 && i != len(t.Windows)-1 {
+			errs = append(errs, fmt.Errorf("%s never ends but is not the last one", prefix))
+		}
+
+		if w.SpawnInterval <= 0 {
+			errs = append(errs, fmt.Errorf("%s spawnInterval must be positive", prefix))
+		}
+
+		if w.MaxEnemies <= 0 {
+			errs = append(errs, fmt.Errorf("%s maxEnemies must be positive", prefix))
+		}
+
+		if w.Health <= 0 || w.Damage <= 0 || w.Speed <= 0 {
+			errs = append(errs, fmt.Errorf("%s health, damage and speed multipliers must be positive", prefix))
+		}
+
+		if w.EliteChance < 0 || w.EliteChance > 1 {
+			errs = append(errs, fmt.Errorf("%s eliteChance must be between 0 and 1", prefix))
+		}
+
+		if w.EliteChance > 0 && w.EliteAffixes <= 0 {
+			errs = append(errs, fmt.Errorf("%s eliteAffixes must be positive when eliteChance is set", prefix))
+		}
+
+		if w.EliteAffixes > len(affixes.Affixes) {
+			errs = append(errs, fmt.Errorf("%s eliteAffixes is higher than the %d known affixes", prefix, len(affixes.Affixes)))
+		}
+
+		if len(w.Spawns) == 0 {
+			errs = append(errs, fmt.Errorf("%s has no spawns", prefix))
+		}
+
+		for _, s := range w.Spawns {
+			if template, exists := templates.EnemyTemplates[s.Type]; !exists {
+				errs = append(errs, fmt.Errorf("%s spawns unknown enemy %q", prefix, s.Type))
+			} else if template.Boss != nil {
+				errs = append(errs, fmt.Errorf("%s spawns boss %q, use a boss event", prefix, s.Type))
+			}
+
+			if s.Weight <= 0 {
+				errs = append(errs, fmt.Errorf("%s spawn %q weight must be positive", prefix, s.Type))
+			}
+		}
+
+		for _, e := range w.Events {
+			if !eventKinds[e.Kind] {
+				errs = append(errs, fmt.Errorf("%s has unknown event %q", prefix, e.Kind))
+			}
+
+			if e.At < w.Start || (w.End != 0 && e.At >= w.End) {
+				errs = append(errs, fmt.Errorf("%s event %q at %.0fs is outside the window", prefix, e.Kind, e.At))
+			}
+
+			if template, exists := templates.EnemyTemplates[e.Type]; !exists {
+				errs = append(errs, fmt.Errorf("%s event %q uses unknown enemy %q", prefix, e.Kind, e.Type))
+			} else if (e.Kind == EventBoss) != (template.Boss != nil) {
+				errs = append(errs, fmt.Errorf("%s event %q cannot spawn %q", prefix, e.Kind, e.Type))
+			}
+
+			if e.Count <= 0 {
+				errs = append(errs, fmt.Errorf("%s event %q count must be positive", prefix, e.Kind))
+			}
+
+			if !formations[e.Formation] {
+				errs = append(errs, fmt.Errorf("%s event %q has unknown formation %q", prefix, e.Kind, e.Formation))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
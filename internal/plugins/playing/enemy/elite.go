@@ -0,0 +1,193 @@
+package enemy
+
+import (
+	"game/internal/helpers/shapes"
+	combatentities "game/internal/plugins/playing/combat/entities"
+	"game/internal/plugins/playing/enemy/affixes"
+	"game/internal/plugins/playing/enemy/director"
+	entity "game/internal/plugins/playing/enemy/entities"
+	playerentities "game/internal/plugins/playing/player/entities"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+const explosionDuration = 0.3
+
+var shieldColor = color.RGBA{120, 180, 255, 200}
+
+type pendingSpawn struct {
+	request director.SpawnRequest
+	x, y    float64
+}
+
+type explosion struct {
+	X, Y   float64
+	Radius float64
+	Timer  float64
+}
+
+// applyAffixes resets the elite state of a spawned enemy and applies the
+// rolled affixes on top of its stats
+func (ep *EnemyPlugin) applyAffixes(enemy *entity.Enemy, names []string) {
+	enemy.Affixes = enemy.Affixes[:0]
+	enemy.Shield = 0
+	enemy.MaxShield = 0
+
+	healthBonus, speedBonus, damageBonus, shield := 0.0, 0.0, 0.0, 0.0
+
+	for _, name := range names {
+		affix, exists := affixes.Affixes[name]
+		if !exists {
+			continue
+		}
+
+		enemy.Affixes = append(enemy.Affixes, affix)
+
+		healthBonus += affix.HealthBonus
+		speedBonus += affix.SpeedBonus
+		damageBonus += affix.DamageBonus
+		shield += affix.Shield
+	}
+
+	enemy.MaxHealth *= 1 + healthBonus
+	enemy.Health = enemy.MaxHealth
+	enemy.Speed *= 1 + speedBonus
+	enemy.Power *= 1 + damageBonus
+
+	enemy.MaxShield = enemy.MaxHealth * shield
+	enemy.Shield = enemy.MaxShield
+}
+
+func (ep *EnemyPlugin) updateElite(enemy *entity.Enemy) {
+	for _, affix := range enemy.Affixes {
+		enemy.Health = math.Min(
+			enemy.MaxHealth,
+			enemy.Health+enemy.MaxHealth*affix.Regeneration*ep.kernel.DeltaTime)
+	}
+}
+
+// lifesteal heals vampiric enemies after they hurt the player
+func (ep *EnemyPlugin) lifesteal(enemy *entity.Enemy, damage float64) {
+	for _, affix := range enemy.Affixes {
+		enemy.Health = math.Min(enemy.MaxHealth, enemy.Health+damage*affix.Lifesteal)
+	}
+}
+
+// eliteDeath triggers the on death affixes, explosions hurt the player right
+// away and split enemies are spawned on the next update
+func (ep *EnemyPlugin) eliteDeath(enemy *entity.Enemy) {
+	centerX := enemy.X + enemy.Width/2
+	centerY := enemy.Y + enemy.Height/2
+
+	for _, affix := range enemy.Affixes {
+		if affix.Explosion.Radius > 0 {
+			ep.explode(centerX, centerY, affix.Explosion)
+		}
+
+		for i := 0; i < affix.Split.Count; i++ {
+			angle := 2 * math.Pi * float64(i) / float64(affix.Split.Count)
+
+			request := ep.director.Request(enemy.Type)
+			request.Health *= affix.Split.Health
+			request.Affixes = nil
+
+			ep.pending = append(ep.pending, pendingSpawn{
+				request: request,
+				x:       enemy.X + math.Cos(angle)*enemy.Width/2,
+				y:       enemy.Y + math.Sin(angle)*enemy.Height/2,
+			})
+		}
+	}
+}
+
+func (ep *EnemyPlugin) explode(x, y float64, e entity.Explosion) {
+	ep.explosions = append(ep.explosions, explosion{
+		X:      x,
+		Y:      y,
+		Radius: e.Radius,
+		Timer:  explosionDuration,
+	})
+
+	area := combatentities.Radius{
+		X:       x,
+		Y:       y,
+		Radius:  e.Radius,
+		Targets: combatentities.TargetPlayer,
+	}
+
+	if !shapes.Intersects(area.Shape(), ep.playerPlugin.GetHitbox()) {
+		return
+	}
+
+	ep.playerPlugin.Hit(playerentities.Hit{
+		Source:       "Explosion",
+		Damage:       e.Damage,
+		SourceX:      x - e.Radius,
+		SourceY:      y - e.Radius,
+		SourceWidth:  e.Radius * 2,
+		SourceHeight: e.Radius * 2,
+		Knockback:    1,
+	})
+}
+
+func (ep *EnemyPlugin) updateExplosions() {
+	active := ep.explosions[:0]
+
+	for _, e := range ep.explosions {
+		e.Timer -= ep.kernel.DeltaTime
+
+		if e.Timer > 0 {
+			active = append(active, e)
+		}
+	}
+
+	ep.explosions = active
+}
+
+func (ep *EnemyPlugin) drawExplosions(screen *ebiten.Image, cameraX, cameraY float64) {
+	for _, e := range ep.explosions {
+		progress := 1 - e.Timer/explosionDuration
+
+		vector.DrawFilledCircle(
+			screen,
+			float32(e.X-cameraX),
+			float32(e.Y-cameraY),
+			float32(e.Radius*progress),
+			color.RGBA{255, 120, 20, uint8(160 * (1 - progress))},
+			true,
+		)
+	}
+}
+
+// drawElite draws one outline per affix around the enemy and its shield bar
+func (ep *EnemyPlugin) drawElite(screen *ebiten.Image, enemy *entity.Enemy, screenX, screenY float64) {
+	for i, affix := range enemy.Affixes {
+		offset := 2 + float64(i)*3
+
+		vector.StrokeRect(
+			screen,
+			float32(screenX-offset),
+			float32(screenY-offset),
+			float32(enemy.Width+offset*2),
+			float32(enemy.Height+offset*2),
+			2,
+			affix.Outline,
+			true,
+		)
+	}
+
+	if enemy.MaxShield > 0 && enemy.Shield > 0 {
+		vector.DrawFilledRect(
+			screen,
+			float32(screenX),
+			float32(screenY-8),
+			float32(enemy.Width*enemy.Shield/enemy.MaxShield),
+			3,
+			shieldColor,
+			true,
+		)
+	}
+}
@@ -0,0 +1,131 @@
+package enemy
+
+import (
+	"game/internal/plugins"
+	entity "game/internal/plugins/playing/enemy/entities"
+	"math"
+)
+
+// shoot fires the enemy template pattern, used by the behaviors
+func (ep *EnemyPlugin) shoot(enemy *entity.Enemy, targetX, targetY float64) {
+	if enemy.Template.Projectile == nil {
+		return
+	}
+
+	ep.emit(enemy, enemy.Template.Projectile, enemy.Template.Pattern, targetX, targetY)
+}
+
+// emit starts a pattern toward the target, bursts keep firing from
+// updateEmitter
+func (ep *EnemyPlugin) emit(
+	enemy *entity.Enemy,
+	projectile *entity.ProjectileDefinition,
+	pattern entity.Pattern,
+	targetX, targetY float64) {
+
+	if pattern.Kind == entity.PatternBurst {
+		enemy.Emitter.ShotsLeft = pattern.Shots
+		enemy.Emitter.Timer = 0
+		enemy.Emitter.Burst = pattern
+		enemy.Emitter.Projectile = projectile
+
+		return
+	}
+
+	ep.volley(enemy, projectile, pattern, targetX, targetY)
+}
+
+func (ep *EnemyPlugin) updateEmitter(enemy *entity.Enemy, targetX, targetY float64) {
+	emitter := &enemy.Emitter
+
+	if emitter.ShotsLeft <= 0 {
+		return
+	}
+
+	emitter.Timer -= ep.kernel.DeltaTime
+	if emitter.Timer > 0 {
+		return
+	}
+
+	ep.volley(enemy, emitter.Projectile, emitter.Burst, targetX, targetY)
+
+	emitter.ShotsLeft--
+	emitter.Timer = emitter.Burst.Interval
+}
+
+// volley fires the projectiles of one pattern step from the enemy center
+func (ep *EnemyPlugin) volley(
+	enemy *entity.Enemy,
+	projectile *entity.ProjectileDefinition,
+	pattern entity.Pattern,
+	targetX, targetY float64) {
+
+	centerX := enemy.X + enemy.Width/2
+	centerY := enemy.Y + enemy.Height/2
+	aim := math.Atan2(targetY-centerY, targetX-centerX)
+
+	switch pattern.Kind {
+	case entity.PatternRing:
+		ep.fireAround(enemy, projectile, pattern.Count, aim, centerX, centerY)
+
+	case entity.PatternSpiral:
+		start := enemy.Emitter.Angle * math.Pi / 180
+		enemy.Emitter.Angle = math.Mod(enemy.Emitter.Angle+pattern.Rotation, 360)
+
+		ep.fireAround(enemy, projectile, pattern.Count, start, centerX, centerY)
+
+	default:
+		// Aimed, spread and burst volleys fan over Angle around the target
+		arc := pattern.Angle * math.Pi / 180
+
+		for i := 0; i < pattern.Count; i++ {
+			offset := 0.0
+			if pattern.Count > 1 {
+				offset = arc * (float64(i)/float64(pattern.Count-1) - 0.5)
+			}
+
+			ep.fire(enemy, projectile, aim+offset, centerX, centerY)
+		}
+	}
+}
+
+func (ep *EnemyPlugin) fireAround(
+	enemy *entity.Enemy,
+	projectile *entity.ProjectileDefinition,
+	count int,
+	start, centerX, centerY float64) {
+
+	for i := 0; i < count; i++ {
+		ep.fire(enemy, projectile, start+2*math.Pi*float64(i)/float64(count), centerX, centerY)
+	}
+}
+
+// fire creates one projectile, its damage follows the enemy power scaling
+func (ep *EnemyPlugin) fire(
+	enemy *entity.Enemy,
+	definition *entity.ProjectileDefinition,
+	angle, centerX, centerY float64) {
+
+	scale := 1.0
+	if enemy.Template.Power > 0 {
+		scale = enemy.Power / enemy.Template.Power
+	}
+
+	hostilePlugin := ep.plugins.GetPlugin("HostileProjectileSystem").(plugins.HostileProjectilePlugin)
+
+	hostilePlugin.Fire(&entity.Projectile{
+		X:          centerX - definition.Size/2,
+		Y:          centerY - definition.Size/2,
+		Width:      definition.Size,
+		Height:     definition.Size,
+		Speed:      definition.Speed,
+		DirectionX: math.Cos(angle),
+		DirectionY: math.Sin(angle),
+		Active:     true,
+		Power:      definition.Damage * scale,
+		Lifetime:   definition.Lifetime,
+		Homing:     definition.Homing,
+		Shooter:    enemy.Name,
+		Definition: definition,
+	})
+}
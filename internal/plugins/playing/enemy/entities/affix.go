@@ -0,0 +1,41 @@
+package entities
+
+import "image/color"
+
+// Affix is an elite modifier rolled on spawn, bonuses are fractions added to
+// the enemy stats, so 0.5 means +50%
+type Affix struct {
+	Name    string
+	Outline color.RGBA
+
+	HealthBonus float64
+	SpeedBonus  float64
+	DamageBonus float64
+
+	// Shield absorbs damage before health, as a fraction of max health
+	Shield float64
+
+	// Regeneration heals a fraction of max health per second
+	Regeneration float64
+
+	// Lifesteal heals a fraction of the contact damage dealt to the player
+	Lifesteal float64
+
+	Explosion Explosion
+	Split     Split
+
+	ExperienceBonus float64
+}
+
+// Explosion damages the player around the enemy when it dies
+type Explosion struct {
+	Radius float64 `json:"radius"`
+	Damage float64 `json:"damage"`
+}
+
+// Split spawns Count enemies of the same type when it dies, with a fraction
+// of its health
+type Split struct {
+	Count  int     `json:"count"`
+	Health float64 `json:"health"`
+}
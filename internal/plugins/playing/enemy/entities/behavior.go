@@ -0,0 +1,30 @@
+package entities
+
+// Behavior decides how an enemy moves and when it attacks, every enemy owns
+// its own instance so behaviors can keep state between frames
+type Behavior interface {
+	Update(enemy *Enemy, ctx *BehaviorContext) Movement
+}
+
+// BehaviorContext is what a behavior can see and do during an update
+type BehaviorContext struct {
+	PlayerX, PlayerY float64
+	DeltaTime        float64
+
+	// Nearby returns the active enemies overlapping the circle
+	Nearby func(x, y, radius float64) []*Enemy
+
+	// PathToPlayer returns the direction to walk around obstacles toward the
+	// player, false when there is no path from the position
+	PathToPlayer func(x, y float64) (float64, float64, bool)
+
+	// Shoot fires a projectile from the enemy toward the target
+	Shoot func(enemy *Enemy, targetX, targetY float64)
+}
+
+// Movement is the direction the behavior wants to move, Speed multiplies the
+// enemy speed, zero keeps the enemy still
+type Movement struct {
+	X, Y  float64
+	Speed float64
+}
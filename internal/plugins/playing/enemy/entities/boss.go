@@ -0,0 +1,89 @@
+package entities
+
+const (
+	BossAttackCharge = "charge"
+	BossAttackShoot  = "shoot"
+	BossAttackSummon = "summon"
+)
+
+// BossDefinition lists the boss phases, ordered by health threshold
+type BossDefinition struct {
+	Phases []BossPhase `json:"phases"`
+}
+
+// BossPhase starts when the boss health fraction drops to Threshold
+type BossPhase struct {
+	Threshold float64      `json:"threshold"`
+	Speed     float64      `json:"speed"` // Speed multiplier
+	Attacks   []BossAttack `json:"attacks"`
+}
+
+type BossAttack struct {
+	Pattern  string  `json:"pattern"`
+	Cooldown float64 `json:"cooldown"`
+
+	// Charge
+	Speed    float64 `json:"speed"`
+	Duration float64 `json:"duration"`
+
+	// Shoot
+	Projectile string  `json:"projectile"`
+	Emission   Pattern `json:"emission"`
+
+	// Summon
+	Count  int       `json:"count"`
+	Summon EnemyType `json:"summon"`
+
+	// ProjectileDefinition is resolved from Projectile when loaded
+	ProjectileDefinition *ProjectileDefinition `json:"-"`
+}
+
+// BossState is the runtime state of a boss enemy
+type BossState struct {
+	Phase        int
+	AttackTimers []float64
+
+	ChargeTimer      float64
+	ChargeSpeed      float64
+	ChargeDirectionX float64
+	ChargeDirectionY float64
+}
+
+func NewBossState(definition *BossDefinition) *BossState {
+	state := &BossState{}
+	state.enterPhase(definition, 0)
+
+	return state
+}
+
+// CurrentPhase returns the active phase definition
+func (b *BossState) CurrentPhase(definition *BossDefinition) *BossPhase {
+	return &definition.Phases[b.Phase]
+}
+
+// NextPhase moves to the next phase when the health fraction crossed its
+// threshold, returns whether the phase changed
+func (b *BossState) NextPhase(definition *BossDefinition, healthFraction float64) bool {
+	next := b.Phase + 1
+
+	if next >= len(definition.Phases) || healthFraction > definition.Phases[next].Threshold {
+		return false
+	}
+
+	b.enterPhase(definition, next)
+
+	return true
+}
+
+func (b *BossState) enterPhase(definition *BossDefinition, phase int) {
+	b.Phase = phase
+	b.AttackTimers = make([]float64, len(definition.Phases[phase].Attacks))
+
+	for i, attack := range definition.Phases[phase].Attacks {
+		b.AttackTimers[i] = attack.Cooldown
+	}
+}
+
+func (b *BossState) IsCharging() bool {
+	return b.ChargeTimer > 0
+}
@@ -2,6 +2,7 @@ package entities
 
 import (
 	"game/internal/assets"
+	"game/internal/helpers/shapes"
 	"math"
 )
 
@@ -12,16 +13,19 @@ type Enemy struct {
 
 	X, Y float64
 
-	Width                            float64
-	Height                           float64
-	Active                           bool
+	Width  float64
+	Height float64
+	Active bool
+
+	// Dying enemies are inactive but still playing the death animation
+	Dying bool
+
 	Speed                            float64
 	Type                             EnemyType
 	Template                         EnemyTemplate
 	Health                           float64
 	MaxHealth                        float64
 	Power                            float64
-	LastPlayerDamageTime             float64
 	LastAreaDamageDeltaTimeByAbility map[string]float64
 	DamageFlashTime                  float64
 
@@ -37,40 +41,55 @@ type Enemy struct {
 	VelocityY float64
 	StuckTime float64
 
-	AttackCooldown  float64
-	AttackRange     float64
-	ProjectileSpeed float64
+	// Steering overrides the behavior direction for a while after the enemy
+	// got stuck on an obstacle
+	SteerX     float64
+	SteerY     float64
+	SteerTimer float64
+
+	Behavior Behavior
+
+	AttackCooldown float64
+	AttackRange    float64
+	Emitter        EmitterState
+
+	// Boss is set for boss enemies only
+	Boss *BossState
+
+	// Affixes are set for elite enemies only
+	Affixes   []*Affix
+	Shield    float64
+	MaxShield float64
 }
 
 func (e *Enemy) GetBounds() (float64, float64, float64, float64) {
 	return e.X, e.Y, e.Width, e.Height
 }
 
-func (e *Enemy) IsEnemyMovingRight(playerX float64) bool {
-	return e.X < playerX
+// Hitbox is the circle inside the enemy sprite
+func (e *Enemy) Hitbox() shapes.Shape {
+	return shapes.Circle{
+		X:      e.X + e.Width/2,
+		Y:      e.Y + e.Height/2,
+		Radius: math.Min(e.Width, e.Height) / 2,
+	}
 }
 
-func (re *Enemy) Shoot(playerX, playerY float64) *Projectile {
-	dx := playerX - re.X
-	dy := playerY - re.Y
-	distance := math.Sqrt(dx*dx + dy*dy)
+func (e *Enemy) IsElite() bool {
+	return len(e.Affixes) > 0
+}
 
-	if distance > 0 {
-		dx /= distance
-		dy /= distance
+// Experience returns the experience dropped by the enemy, boosted by its
+// affixes
+func (e *Enemy) Experience() int {
+	bonus := 0.0
+	for _, affix := range e.Affixes {
+		bonus += affix.ExperienceBonus
 	}
 
-	projectile := &Projectile{
-		X:          re.X,
-		Y:          re.Y,
-		Width:      8,
-		Height:     8,
-		Speed:      re.ProjectileSpeed,
-		DirectionX: dx,
-		DirectionY: dy,
-		Active:     true,
-		Power:      10,
-	}
+	return int(math.Round(float64(e.Template.Experience) * (1 + bonus)))
+}
 
-	return projectile
+func (e *Enemy) IsEnemyMovingRight(playerX float64) bool {
+	return e.X < playerX
 }
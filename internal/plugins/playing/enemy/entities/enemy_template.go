@@ -2,6 +2,8 @@ package entities
 
 import (
 	"game/internal/assets"
+	"game/internal/plugins/playing/damage"
+	"math/rand"
 )
 
 type EnemyTemplate struct {
@@ -12,6 +14,26 @@ type EnemyTemplate struct {
 	Size      float64
 	Power     float64
 
+	// Armor reduces physical damage by percent, resistances are fractions of
+	// the damage of their type ignored, negative for weaknesses
+	Armor       float64
+	Resistances map[damage.Type]float64
+
+	Behavior string
+
+	AttackCooldown float64
+	AttackRange    float64
+
+	// Projectile is nil for enemies that don't shoot
+	Projectile *ProjectileDefinition
+	Pattern    Pattern
+
+	Experience int
+	Loot       LootTable
+
+	// Boss is set for boss enemies only
+	Boss *BossDefinition
+
 	RunningRightAnimationSprite *assets.Animation
 	RunningLeftAnimationSprite  *assets.Animation
 	CurrentAnimation            *assets.Animation
@@ -20,3 +42,38 @@ type EnemyTemplate struct {
 
 	DeathAnimation *assets.Animation
 }
+
+// LootTable is rolled when the enemy dies, Chance is the probability of
+// dropping one of the entries, picked by weight
+type LootTable struct {
+	Chance  float64     `json:"chance"`
+	Entries []LootEntry `json:"entries"`
+}
+
+type LootEntry struct {
+	Item   string  `json:"item"`
+	Weight float64 `json:"weight"`
+}
+
+// Roll returns the dropped item, if any
+func (t LootTable) Roll() (string, bool) {
+	if len(t.Entries) == 0 || rand.Float64() >= t.Chance {
+		return "", false
+	}
+
+	total := 0.0
+	for _, entry := range t.Entries {
+		total += entry.Weight
+	}
+
+	r := rand.Float64() * total
+
+	for _, entry := range t.Entries {
+		r -= entry.Weight
+		if r < 0 {
+			return entry.Item, true
+		}
+	}
+
+	return t.Entries[len(t.Entries)-1].Item, true
+}
@@ -1,5 +1,11 @@
 package entities
 
+import (
+	"game/internal/assets"
+	"game/internal/helpers/shapes"
+	"image/color"
+)
+
 type Projectile struct {
 	X, Y          float64
 	Width, Height float64
@@ -8,4 +14,92 @@ type Projectile struct {
 	DirectionY    float64
 	Active        bool
 	Power         float64
+
+	// Lifetime is the remaining time before the projectile fades
+	Lifetime float64
+
+	// Homing is the turn rate toward the player in radians per second
+	Homing float64
+
+	// Reflected projectiles hit the enemies instead of the player, credited
+	// to the ability that reflected them
+	Reflected   bool
+	ReflectedBy string
+
+	// Shooter is the name of the enemy that fired the projectile
+	Shooter string
+
+	Definition *ProjectileDefinition
+}
+
+// Source names the projectile in the combat events, credited to its shooter
+// like the melee hits
+func (p *Projectile) Source() string {
+	if p.Shooter != "" {
+		return p.Shooter
+	}
+
+	if p.Definition == nil {
+		return "Projectile"
+	}
+
+	return p.Definition.Name
+}
+
+func (p *Projectile) Hitbox() shapes.Shape {
+	return shapes.AABB{X: p.X, Y: p.Y, Width: p.Width, Height: p.Height}
+}
+
+// ProjectileDefinition describes a hostile projectile type, loaded from the
+// embedded data files
+type ProjectileDefinition struct {
+	Name     string
+	Size     float64
+	Speed    float64
+	Damage   float64
+	Lifetime float64
+	Homing   float64
+
+	// Color is used when there is no animation
+	Color     color.RGBA
+	Animation *assets.Animation
+}
+
+const (
+	// PatternAimed shoots Count projectiles straight at the target
+	PatternAimed = "aimed"
+
+	// PatternSpread fans Count projectiles over Angle degrees around the target
+	PatternSpread = "spread"
+
+	// PatternRing shoots Count projectiles evenly around the enemy
+	PatternRing = "ring"
+
+	// PatternSpiral is a ring that turns Rotation degrees every volley
+	PatternSpiral = "spiral"
+
+	// PatternBurst shoots Shots spread volleys, Interval seconds apart
+	PatternBurst = "burst"
+)
+
+// Pattern describes how an enemy emits its projectiles
+type Pattern struct {
+	Kind     string  `json:"kind"`
+	Count    int     `json:"count"`
+	Angle    float64 `json:"angle"`
+	Rotation float64 `json:"rotation"`
+	Shots    int     `json:"shots"`
+	Interval float64 `json:"interval"`
+}
+
+// EmitterState keeps the pattern progress of an enemy between volleys
+type EmitterState struct {
+	// Angle is the current spiral rotation in degrees
+	Angle float64
+
+	// Burst in progress
+	ShotsLeft  int
+	Timer      float64
+	Burst      Pattern
+	Projectile *ProjectileDefinition
 }
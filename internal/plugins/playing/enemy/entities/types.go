@@ -1,3 +1,4 @@
 package entities
 
-type EnemyType int
+// EnemyType is the name of the enemy template
+type EnemyType string
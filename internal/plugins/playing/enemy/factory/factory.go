@@ -2,6 +2,7 @@ package factory
 
 import (
 	"game/internal/assets"
+	"game/internal/plugins/playing/enemy/behaviors"
 	"game/internal/plugins/playing/enemy/entities"
 	"game/internal/plugins/playing/enemy/templates"
 
@@ -11,34 +12,70 @@ import (
 func CreateEnemy(enemyType entities.EnemyType, x, y float64) *entities.Enemy {
 	template := templates.EnemyTemplates[enemyType]
 
-	randomUUID := uuid.NewString()
-
-	currentAnimation := dupAnimation(template.RunningRightAnimationSprite)
-
-	return &entities.Enemy{
+	enemy := &entities.Enemy{
 		Name:                             template.Name,
-		UUID:                             randomUUID,
-		X:                                x,
-		Y:                                y,
-		Width:                            template.Size,
-		Height:                           template.Size,
-		Active:                           true,
 		Type:                             enemyType,
 		Template:                         *template,
-		Speed:                            template.Speed,
-		Health:                           template.MaxHealth,
-		Power:                            template.Power,
 		LastAreaDamageDeltaTimeByAbility: map[string]float64{},
-		MaxHealth:                        template.MaxHealth,
-		RunningRightAnimationSprite:      currentAnimation,
+		RunningRightAnimationSprite:      dupAnimation(template.RunningRightAnimationSprite),
 		RunningLeftAnimationSprite:       dupAnimation(template.RunningLeftAnimationSprite),
 		DeathAnimation:                   dupAnimation(template.DeathAnimation),
-		CurrentAnimation:                 currentAnimation,
+	}
+
+	Reset(enemy, x, y)
+
+	return enemy
+}
+
+// Reset brings an enemy back to the state of a freshly created one at the
+// position, pooled enemies go through it before being reused so every
+// runtime field of the enemy must be reset here
+func Reset(enemy *entities.Enemy, x, y float64) {
+	template := &enemy.Template
+
+	// A new identity, abilities remember the enemies they already hit
+	enemy.UUID = uuid.NewString()
+
+	enemy.X = x
+	enemy.Y = y
+	enemy.Width = template.Size
+	enemy.Height = template.Size
+	enemy.Active = true
+	enemy.Dying = false
 
-		AttackCooldown:  2.0, // Seconds between attacks
-		AttackRange:     800, // Range to start shooting
-		ProjectileSpeed: 200,
+	enemy.Speed = template.Speed
+	enemy.Health = template.MaxHealth
+	enemy.MaxHealth = template.MaxHealth
+	enemy.Power = template.Power
+
+	clear(enemy.LastAreaDamageDeltaTimeByAbility)
+	enemy.DamageFlashTime = 0
+
+	resetAnimation(enemy.RunningRightAnimationSprite)
+	resetAnimation(enemy.RunningLeftAnimationSprite)
+	resetAnimation(enemy.DeathAnimation)
+	enemy.CurrentAnimation = enemy.RunningRightAnimationSprite
+
+	enemy.VelocityX = 0
+	enemy.VelocityY = 0
+	enemy.StuckTime = 0
+	enemy.SteerX = 0
+	enemy.SteerY = 0
+	enemy.SteerTimer = 0
+
+	enemy.AttackCooldown = template.AttackCooldown
+	enemy.AttackRange = template.AttackRange
+	enemy.Emitter = entities.EmitterState{}
+	enemy.Behavior = behaviors.New(template)
+
+	enemy.Boss = nil
+	if template.Boss != nil {
+		enemy.Boss = entities.NewBossState(template.Boss)
 	}
+
+	enemy.Affixes = enemy.Affixes[:0]
+	enemy.Shield = 0
+	enemy.MaxShield = 0
 }
 
 func dupAnimation(a *assets.Animation) *assets.Animation {
@@ -46,3 +83,8 @@ func dupAnimation(a *assets.Animation) *assets.Animation {
 
 	return &val
 }
+
+func resetAnimation(a *assets.Animation) {
+	a.CurrentFrame = 0
+	a.FrameTimer = 0
+}
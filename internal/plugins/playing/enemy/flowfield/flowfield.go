@@ -0,0 +1,169 @@
+package flowfield
+
+import "math"
+
+const unreachable = -1
+
+// Field holds the walking distance in tiles from every tile of an area to
+// the target tile, enemies follow it downhill to walk around obstacles
+type Field struct {
+	tileSize float64
+
+	originX, originY int
+	width, height    int
+
+	targetX, targetY int
+	built            bool
+
+	distance []int
+	walkable []bool
+	queue    []int
+}
+
+func New(tileSize float64) *Field {
+	return &Field{tileSize: tileSize}
+}
+
+// Target returns the tile the field leads to and whether it was built
+func (f *Field) Target() (int, int, bool) {
+	return f.targetX, f.targetY, f.built
+}
+
+// Build runs a breadth first search from the target tile over the walkable
+// tiles inside the bounds, bounds are inclusive tile coordinates
+func (f *Field) Build(
+	targetX, targetY int,
+	minX, minY, maxX, maxY int,
+	isWalkable func(tileX, tileY int) bool) {
+
+	f.originX, f.originY = minX, minY
+	f.width = maxX - minX + 1
+	f.height = maxY - minY + 1
+	f.targetX, f.targetY = targetX, targetY
+	f.built = true
+
+	size := f.width * f.height
+	if cap(f.distance) < size {
+		f.distance = make([]int, size)
+		f.walkable = make([]bool, size)
+	}
+
+	f.distance = f.distance[:size]
+	f.walkable = f.walkable[:size]
+
+	for i := range f.distance {
+		f.distance[i] = unreachable
+		f.walkable[i] = isWalkable(f.originX+i%f.width, f.originY+i/f.width)
+	}
+
+	start, ok := f.index(targetX, targetY)
+	if !ok {
+		return
+	}
+
+	// The player tile is always a valid target, even when standing on an edge
+	f.distance[start] = 0
+	f.queue = append(f.queue[:0], start)
+
+	neighbours := [4][2]int{{1, 0}, {-1, 0}, {0, 1}, {0, -1}}
+
+	for len(f.queue) > 0 {
+		current := f.queue[0]
+		f.queue = f.queue[1:]
+
+		x, y := current%f.width, current/f.width
+
+		for _, n := range neighbours {
+			nx, ny := x+n[0], y+n[1]
+			if nx < 0 || ny < 0 || nx >= f.width || ny >= f.height {
+				continue
+			}
+
+			next := ny*f.width + nx
+			if !f.walkable[next] || f.distance[next] != unreachable {
+				continue
+			}
+
+			f.distance[next] = f.distance[current] + 1
+			f.queue = append(f.queue, next)
+		}
+	}
+}
+
+// Direction returns the normalized direction toward the neighbour tile
+// closest to the target, false when the position is outside the field or
+// can't reach the target
+func (f *Field) Direction(worldX, worldY float64) (float64, float64, bool) {
+	tileX := int(math.Floor(worldX / f.tileSize))
+	tileY := int(math.Floor(worldY / f.tileSize))
+
+	current, ok := f.index(tileX, tileY)
+	if !ok || f.distance[current] == unreachable {
+		return 0, 0, false
+	}
+
+	if f.distance[current] == 0 {
+		return 0, 0, false
+	}
+
+	best := f.distance[current]
+	bestX, bestY := 0, 0
+
+	for dx := -1; dx <= 1; dx++ {
+		for dy := -1; dy <= 1; dy++ {
+			if dx == 0 && dy == 0 {
+				continue
+			}
+
+			// Diagonals can't cut the corner of an obstacle
+			if dx != 0 && dy != 0 && (!f.isWalkable(tileX+dx, tileY) || !f.isWalkable(tileX, tileY+dy)) {
+				continue
+			}
+
+			next, ok := f.index(tileX+dx, tileY+dy)
+			if !ok || f.distance[next] == unreachable {
+				continue
+			}
+
+			if f.distance[next] < best {
+				best = f.distance[next]
+				bestX, bestY = dx, dy
+			}
+		}
+	}
+
+	if bestX == 0 && bestY == 0 {
+		return 0, 0, false
+	}
+
+	// Aim at the center of the next tile so enemies don't scrape the edges
+	targetX := (float64(tileX+bestX) + 0.5) * f.tileSize
+	targetY := (float64(tileY+bestY) + 0.5) * f.tileSize
+
+	dirX := targetX - worldX
+	dirY := targetY - worldY
+	length := math.Sqrt(dirX*dirX + dirY*dirY)
+
+	if length == 0 {
+		return 0, 0, false
+	}
+
+	return dirX / length, dirY / length, true
+}
+
+func (f *Field) isWalkable(tileX, tileY int) bool {
+	i, ok := f.index(tileX, tileY)
+
+	return ok && f.walkable[i]
+}
+
+func (f *Field) index(tileX, tileY int) (int, bool) {
+	x := tileX - f.originX
+	y := tileY - f.originY
+
+	if !f.built || x < 0 || y < 0 || x >= f.width || y >= f.height {
+		return 0, false
+	}
+
+	return y*f.width + x, true
+}
@@ -0,0 +1,84 @@
+package enemy
+
+import (
+	entity "game/internal/plugins/playing/enemy/entities"
+	"game/internal/plugins/playing/enemy/flowfield"
+	"game/internal/plugins/playing/scenario"
+	"math"
+)
+
+const (
+	// stuckMovementRatio is the fraction of the intended movement below
+	// which an enemy counts as blocked
+	stuckMovementRatio = 0.25
+	stuckThreshold     = 0.4
+	steerDuration      = 0.5
+)
+
+// updateFlowField rebuilds the shared flow field whenever the player enters
+// another tile
+func (ep *EnemyPlugin) updateFlowField(playerX, playerY float64) {
+	scenarioPlugin := ep.plugins.GetPlugin("ScenarioSystem").(*scenario.ScenarioPlugin)
+
+	if ep.flowField == nil {
+		ep.flowField = flowfield.New(scenarioPlugin.TileSize())
+	}
+
+	tileX, tileY := scenarioPlugin.TileCoords(playerX, playerY)
+
+	if x, y, built := ep.flowField.Target(); built && x == tileX && y == tileY {
+		return
+	}
+
+	minX, minY, maxX, maxY := scenarioPlugin.ActiveTileBounds(playerX, playerY)
+
+	ep.flowField.Build(tileX, tileY, minX, minY, maxX, maxY, scenarioPlugin.IsTileWalkableAt)
+}
+
+func (ep *EnemyPlugin) pathToPlayer(x, y float64) (float64, float64, bool) {
+	return ep.flowField.Direction(x, y)
+}
+
+// steer replaces the movement direction when the enemy is stuck, sliding
+// along the obstacle to whichever side is free
+func (ep *EnemyPlugin) steer(enemy *entity.Enemy, movement *entity.Movement) {
+	if enemy.SteerTimer > 0 {
+		enemy.SteerTimer -= ep.kernel.DeltaTime
+		movement.X, movement.Y = enemy.SteerX, enemy.SteerY
+
+		return
+	}
+
+	if enemy.StuckTime < stuckThreshold || (movement.X == 0 && movement.Y == 0) {
+		return
+	}
+
+	scenarioPlugin := ep.plugins.GetPlugin("ScenarioSystem").(*scenario.ScenarioPlugin)
+	probe := scenarioPlugin.TileSize()
+
+	length := math.Hypot(movement.X, movement.Y)
+	dx, dy := movement.X/length, movement.Y/length
+
+	// Perpendicular directions, the free one wins, the left one by default
+	sides := [2][2]float64{{-dy, dx}, {dy, -dx}}
+	if enemy.SteerX*sides[1][0]+enemy.SteerY*sides[1][1] > 0 {
+		sides[0], sides[1] = sides[1], sides[0]
+	}
+
+	enemy.SteerX, enemy.SteerY = sides[0][0], sides[0][1]
+
+	for _, side := range sides {
+		if scenarioPlugin.IsAreaWalkable(
+			enemy.X+side[0]*probe, enemy.Y+side[1]*probe,
+			enemy.Width, enemy.Height) {
+
+			enemy.SteerX, enemy.SteerY = side[0], side[1]
+			break
+		}
+	}
+
+	enemy.SteerTimer = steerDuration
+	enemy.StuckTime = 0
+
+	movement.X, movement.Y = enemy.SteerX, enemy.SteerY
+}
@@ -6,19 +6,24 @@ import (
 	"game/internal/config"
 	"game/internal/constants"
 	"game/internal/core"
-	"game/internal/helpers/collision"
+	"game/internal/helpers/shapes"
+	"game/internal/helpers/spatial"
 
 	"game/internal/plugins/menu/fontface"
 	"game/internal/plugins/playing/camera"
+	"game/internal/plugins/playing/damage"
+	"game/internal/plugins/playing/enemy/director"
 	"game/internal/plugins/playing/enemy/entities"
 	entity "game/internal/plugins/playing/enemy/entities"
-	"game/internal/plugins/playing/enemy/factory"
+	"game/internal/plugins/playing/enemy/flowfield"
+	"game/internal/plugins/playing/enemy/pool"
 	"game/internal/plugins/playing/enemy/templates"
 	"game/internal/plugins/playing/player"
+	playerentities "game/internal/plugins/playing/player/entities"
+	"game/internal/plugins/playing/scenario"
 
 	"image/color"
 	"math"
-	"math/rand"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	_ "github.com/hajimehoshi/ebiten/v2/ebitenutil"
@@ -32,63 +37,65 @@ type DamageInfo struct {
 	Value float64
 	Color color.Color
 	Timer float64
+
+	// Critical numbers keep the damage type color, marked by a "!"
+	Critical bool
 }
 
+const (
+	gridCellSize       = 64
+	separationDistance = 40
+
+	// maxActiveEnemies caps the enemies alive at once, bosses ignore it
+	maxActiveEnemies = 400
+)
+
 type EnemyPlugin struct {
 	kernel  *core.GameKernel
 	plugins *core.PluginManager
 
-	enemies         []*entity.Enemy
-	inactiveEnemies []*entity.Enemy
+	enemies []*entity.Enemy
+
+	// pool keeps the released enemies by type, enemies are only released
+	// after the update loop
+	pool *pool.Pool
+
+	// rejected counts the spawns refused by the hard cap and misplaced the
+	// ones without a free position on the spawn ring
+	rejected  int
+	misplaced int
+
+	// travel is the smoothed player movement per update, spawns are biased
+	// toward it
+	travelX, travelY         float64
+	lastPlayerX, lastPlayerY float64
 
 	deathEnemies []*entity.Enemy
 
-	spawnTimer   float64
 	playerPlugin *player.PlayerPlugin
 	StaticAsset  *assets.StaticSprite
 
 	damages []DamageInfo // Lista de danos causados pelos inimigos
 
-	maxEnemies int // Número máximo de inimigos por nível
+	director *director.Director
 
-	gameTimer  float64
-	spawnRates map[int]float64 // Maps minutes to spawn delay
+	// flowField leads the enemies around obstacles toward the player
+	flowField *flowfield.Field
 
-	baseStats map[int]EnemyBaseStats
+	// grid indexes the active enemies, rebuilt at the end of every update
+	grid *spatial.Grid[*entity.Enemy]
 
-	globalProjectiles []*entity.Projectile
-}
+	// pending enemies are spawned after the enemies loop
+	pending []pendingSpawn
 
-type EnemyBaseStats struct {
-	Health float64
-	Damage float64
+	explosions []explosion
 }
 
 func NewEnemyPlugin(playerPlugin *player.PlayerPlugin, plugins *core.PluginManager) *EnemyPlugin {
 	return &EnemyPlugin{
 		enemies:      []*entity.Enemy{},
-		spawnTimer:   0,
 		playerPlugin: playerPlugin,
 		plugins:      plugins,
-		maxEnemies:   10,
-		spawnRates: map[int]float64{
-			0: 2.0,
-			1: 1.0,
-			2: 0.7,
-			3: 0.5,
-			4: 0.3,
-			5: 0.2,
-			6: 0.1,
-		},
-		baseStats: map[int]EnemyBaseStats{
-			0: {Health: 0.1, Damage: 0.1},
-			1: {Health: 0.2, Damage: 0.2},
-			2: {Health: 0.4, Damage: 0.4},
-			3: {Health: 0.6, Damage: 0.6},
-			4: {Health: 0.8, Damage: 0.8},
-			5: {Health: 0.9, Damage: 0.9},
-			6: {Health: 1.0, Damage: 1.0},
-		},
 	}
 }
 
@@ -98,40 +105,60 @@ func (ep *EnemyPlugin) ID() string {
 
 func (ep *EnemyPlugin) Init(kernel *core.GameKernel) error {
 	ep.kernel = kernel
-	ep.globalProjectiles = []*entity.Projectile{}
+
+	timeline, exists := director.Timelines[config.Timeline()]
+	if !exists {
+		return fmt.Errorf("unknown wave timeline %q", config.Timeline())
+	}
+
+	ep.director = director.New(timeline)
+	ep.grid = spatial.NewGrid[*entity.Enemy](gridCellSize)
+	ep.pool = pool.New()
+
+	ep.lastPlayerX, ep.lastPlayerY = ep.playerPlugin.GetPosition()
 
 	return nil
 }
 
 func (ep *EnemyPlugin) Update() error {
-	// Update game timer
-	ep.gameTimer += ep.kernel.DeltaTime
-	ep.spawnTimer += ep.kernel.DeltaTime
-
-	// Get current minute
-	currentMinute := int(ep.gameTimer / 60)
+	playerX, playerY := ep.playerPlugin.GetPosition()
 
-	// Get spawn delay based on current minute
-	spawnDelay := ep.spawnRates[6] // Default to highest difficulty
-	if delay, exists := ep.spawnRates[currentMinute]; exists {
-		spawnDelay = delay
-	}
+	ep.updateTravel(playerX, playerY)
 
-	// Spawn enemy based on current spawn rate
-	if ep.spawnTimer >= spawnDelay {
-		ep.Spawn()
-		ep.spawnTimer = 0
+	for _, group := range ep.director.Update(ep.kernel.DeltaTime, ep.countActive()) {
+		ep.spawnGroup(group)
 	}
 
-	playerX, playerY := ep.playerPlugin.GetPosition()
-	playerWidth, playerHeight := ep.playerPlugin.GetSize()
+	playerHitbox := ep.playerPlugin.GetHitbox()
 
 	cameraPlugin := ep.plugins.GetPlugin("CameraSystem").(*camera.CameraPlugin)
 	cameraX, cameraY := cameraPlugin.GetPosition()
 
-	for i, enemy := range ep.enemies {
+	ep.updateFlowField(playerX, playerY)
+
+	ctx := &entity.BehaviorContext{
+		PlayerX:   playerX,
+		PlayerY:   playerY,
+		DeltaTime: ep.kernel.DeltaTime,
+		Nearby:    ep.EnemiesInRadius,
+		Shoot:     ep.shoot,
+
+		PathToPlayer: ep.pathToPlayer,
+	}
+
+	for _, enemy := range ep.enemies {
 		if enemy.Active {
-			ep.moveTowardsPlayer(enemy, playerX, playerY)
+			if enemy.IsElite() {
+				ep.updateElite(enemy)
+			}
+
+			if enemy.Boss != nil {
+				ep.updateBoss(enemy, ctx)
+			} else {
+				ep.moveEnemy(enemy, ctx)
+			}
+
+			ep.updateEmitter(enemy, playerX, playerY)
 
 			if enemy.IsEnemyMovingRight(playerX) {
 				enemy.CurrentAnimation = enemy.RunningRightAnimationSprite
@@ -141,57 +168,31 @@ func (ep *EnemyPlugin) Update() error {
 
 			enemy.CurrentAnimation.Update(ep.kernel.DeltaTime)
 
-			playerCollision := collision.Check(
-				enemy.X, enemy.Y,
-				enemy.Width, enemy.Height,
-				(playerX - playerWidth/2), (playerY - playerHeight/2),
-				playerWidth, playerHeight)
-
-			if playerCollision {
-				if enemy.LastPlayerDamageTime == 0 || enemy.LastPlayerDamageTime >= 0.5 {
-					ep.playerPlugin.ApplyDamage(enemy.Power)
-					ep.playerPlugin.DamageFlashTime = 0.3
-
-					if enemy.LastPlayerDamageTime == 0 {
-						enemy.LastPlayerDamageTime += ep.kernel.DeltaTime
-					} else {
-						enemy.LastPlayerDamageTime = 0
-					}
-				} else {
-					enemy.LastPlayerDamageTime += ep.kernel.DeltaTime
-					ep.playerPlugin.DamageFlashTime += ep.kernel.DeltaTime
+			if shapes.Intersects(enemy.Hitbox(), playerHitbox) {
+				amount, hit := ep.playerPlugin.Hit(playerentities.Hit{
+					Source:       enemy.Name,
+					Damage:       enemy.Power,
+					SourceX:      enemy.X,
+					SourceY:      enemy.Y,
+					SourceWidth:  enemy.Width,
+					SourceHeight: enemy.Height,
+					Knockback:    1,
+					Solid:        true,
+				})
+
+				if hit {
+					ep.lifesteal(enemy, amount)
 				}
 			}
 
-			// Margem extra de 200 pixels além da tela
-			const marginBeyondScreen = 200
-
-			// Verificar se o inimigo está muito além dos limites da tela
-			if enemy.X < cameraX-marginBeyondScreen ||
-				enemy.X > cameraX+constants.ScreenWidth+marginBeyondScreen ||
-				enemy.Y < cameraY-marginBeyondScreen ||
-				enemy.Y > cameraY+constants.ScreenHeight+marginBeyondScreen {
+			// Verificar se o inimigo está muito além dos limites da tela,
+			// chefes nunca são descartados
+			if enemy.Boss == nil && (enemy.X < cameraX-director.CullMargin ||
+				enemy.X > cameraX+constants.ScreenWidth+director.CullMargin ||
+				enemy.Y < cameraY-director.CullMargin ||
+				enemy.Y > cameraY+constants.ScreenHeight+director.CullMargin) {
 
 				enemy.Active = false
-				ep.inactiveEnemies = append(ep.inactiveEnemies, enemy)
-				ep.enemies = append(ep.enemies[:i], ep.enemies[i+1:]...)
-			}
-
-			if enemy.Name == "ranged" {
-				// Calculate distance to player
-				dx := playerX - enemy.X
-				dy := playerY - enemy.Y
-				distance := math.Sqrt(dx*dx + dy*dy)
-
-				// Attack if in range
-				if distance <= enemy.AttackRange {
-					enemy.AttackCooldown -= ep.kernel.DeltaTime
-					if enemy.AttackCooldown <= 0 {
-						ep.globalProjectiles = append(ep.globalProjectiles, enemy.Shoot(playerX, playerY))
-
-						enemy.AttackCooldown = 2.0 // Reset cooldown
-					}
-				}
 			}
 		}
 
@@ -201,20 +202,88 @@ func (ep *EnemyPlugin) Update() error {
 		}
 	}
 
+	for _, s := range ep.pending {
+		ep.spawnAt(s.request, s.x, s.y)
+	}
+
+	ep.pending = ep.pending[:0]
+
+	ep.releaseInactive()
+	ep.rebuildGrid()
+
+	ep.updateExplosions()
+
+	ep.updateDeathEnemies()
+
+	ep.reportMetrics()
+
+	return nil
+}
+
+// releaseInactive removes the inactive enemies from the list, returning them
+// to the pool unless they are still playing the death animation
+func (ep *EnemyPlugin) releaseInactive() {
+	active := ep.enemies[:0]
+
+	for _, enemy := range ep.enemies {
+		if enemy.Active {
+			active = append(active, enemy)
+			continue
+		}
+
+		if !enemy.Dying {
+			ep.pool.Put(enemy)
+		}
+	}
+
+	clear(ep.enemies[len(active):])
+	ep.enemies = active
+}
+
+// updateDeathEnemies plays the death animations, each enemy goes back to
+// the pool once its own animation ends
+func (ep *EnemyPlugin) updateDeathEnemies() {
+	dying := ep.deathEnemies[:0]
+
 	for _, enemy := range ep.deathEnemies {
 		enemy.DeathAnimation.Update(ep.kernel.DeltaTime)
 
 		if enemy.DeathAnimation.CurrentFrame+1 >= len(enemy.DeathAnimation.Frames) {
-			ep.deathEnemies = ep.deathEnemies[:0]
+			enemy.Dying = false
+			ep.pool.Put(enemy)
+			continue
 		}
+
+		dying = append(dying, enemy)
 	}
 
-	return nil
+	clear(ep.deathEnemies[len(dying):])
+	ep.deathEnemies = dying
+}
+
+func (ep *EnemyPlugin) reportMetrics() {
+	profiler := ep.kernel.Profiler
+
+	profiler.Set("enemies.active", float64(len(ep.enemies)))
+	profiler.Set("enemies.dying", float64(len(ep.deathEnemies)))
+	profiler.Set("enemies.rejected", float64(ep.rejected))
+	profiler.Set("enemies.misplaced", float64(ep.misplaced))
+
+	for _, stats := range ep.pool.Stats() {
+		prefix := "enemies.pool." + string(stats.Type)
+
+		profiler.Set(prefix+".free", float64(stats.Free))
+		profiler.Set(prefix+".created", float64(stats.Created))
+		profiler.Set(prefix+".reused", float64(stats.Reused))
+	}
 }
 
 func (ep *EnemyPlugin) checkEnemyCollision(x, y float64, currentEnemy *entity.Enemy) bool {
-	for _, enemy := range ep.enemies {
-		if enemy != currentEnemy && enemy.Active {
+	for _, enemy := range ep.EnemiesInRect(
+		x-currentEnemy.Width, y-currentEnemy.Height,
+		currentEnemy.Width*3, currentEnemy.Height*3) {
+
+		if enemy != currentEnemy {
 			if math.Abs(enemy.X-x) < currentEnemy.Width && math.Abs(enemy.Y-y) < currentEnemy.Height {
 				return true
 			}
@@ -269,27 +338,15 @@ func (ep *EnemyPlugin) Draw(screen *ebiten.Image) {
 						enemy.CurrentAnimation.Draw(screen, input)
 					}
 				}
+
+				if enemy.IsElite() {
+					ep.drawElite(screen, enemy, screenX, screenY)
+				}
 			}
 		}
 	}
 
-	// Draw global projectiles
-	for _, p := range ep.globalProjectiles {
-		if p.Active {
-			screenX := p.X - cameraX
-			screenY := p.Y - cameraY
-
-			vector.DrawFilledRect(
-				screen,
-				float32(screenX),
-				float32(screenY),
-				float32(p.Width),
-				float32(p.Height),
-				color.RGBA{255, 0, 0, 255},
-				true,
-			)
-		}
-	}
+	ep.drawExplosions(screen, cameraX, cameraY)
 
 	// Desenhar os inimigos mortos
 	for _, enemy := range ep.deathEnemies {
@@ -318,13 +375,18 @@ func (ep *EnemyPlugin) Draw(screen *ebiten.Image) {
 			screenX := damage.X - cameraX
 			screenY := damage.Y - cameraY - (1.0-damage.Timer)*20
 
-			drawValue := int(damage.Value)
-			text.Draw(screen, fmt.Sprintf("%d", drawValue), basicfont.Face7x13, int(screenX), int(screenY), damage.Color)
+			drawValue := fmt.Sprintf("%d", int(damage.Value))
+			if damage.Critical {
+				drawValue += "!"
+			}
+
+			text.Draw(screen, drawValue, basicfont.Face7x13, int(screenX), int(screenY), damage.Color)
 		}
 	}
 
-	minutes := int(ep.gameTimer / 60)
-	seconds := int(ep.gameTimer) % 60
+	gameTimer := ep.director.Time()
+	minutes := int(gameTimer / 60)
+	seconds := int(gameTimer) % 60
 	timerText := fmt.Sprintf("%02d:%02d", minutes, seconds)
 
 	text.Draw(
@@ -336,96 +398,126 @@ func (ep *EnemyPlugin) Draw(screen *ebiten.Image) {
 		color.White)
 }
 
+// Spawn adds a random enemy from the current wave window
 func (ep *EnemyPlugin) Spawn() {
-	playerX, playerY := ep.playerPlugin.GetPosition()
+	if request, ok := ep.director.Pick(); ok {
+		ep.spawn(request)
+	}
+}
 
-	// Escolher uma borda aleatória (0: superior, 1: inferior, 2: esquerda, 3: direita)
-	border := rand.Intn(4)
-	var x, y float64
-
-	switch border {
-	case 0: // Superior
-		x = playerX + rand.Float64()*constants.ScreenWidth - constants.ScreenWidth/2
-		y = playerY - constants.ScreenHeight/2
-	case 1: // Inferior
-		x = playerX + rand.Float64()*constants.ScreenWidth - constants.ScreenWidth/2
-		y = playerY + constants.ScreenHeight/2
-	case 2: // Esquerda
-		x = playerX - constants.ScreenWidth/2
-		y = playerY + rand.Float64()*constants.ScreenHeight - constants.ScreenHeight/2
-	case 3: // Direita
-		x = playerX + constants.ScreenWidth/2
-		y = playerY + rand.Float64()*constants.ScreenHeight - constants.ScreenHeight/2
+func (ep *EnemyPlugin) spawnAt(request director.SpawnRequest, x, y float64) {
+	// The list still holds the enemies killed this frame, so the cap is
+	// conservative until they are released
+	if len(ep.enemies) >= maxActiveEnemies && templates.EnemyTemplates[request.Type].Boss == nil {
+		ep.rejected++
+		return
 	}
 
-	var enemy *entity.Enemy
+	enemy := ep.pool.Get(request.Type, x, y)
 
-	if len(ep.inactiveEnemies) > 0 {
-		// Reutilizar um inimigo inativo
-		enemy = ep.inactiveEnemies[len(ep.inactiveEnemies)-1]
-		ep.inactiveEnemies = ep.inactiveEnemies[:len(ep.inactiveEnemies)-1]
-		enemy.X = x
-		enemy.Y = y
-		enemy.Health = enemy.MaxHealth
-		enemy.Active = true
+	enemy.MaxHealth = enemy.Template.MaxHealth * request.Health
+	enemy.Health = enemy.MaxHealth
+	enemy.Power = enemy.Template.Power * request.Damage
+	enemy.Speed = enemy.Template.Speed * request.Speed
 
-	} else {
-		// Criar um novo inimigo
-		enemyType := entities.EnemyType(rand.Intn(len(templates.EnemyTemplates)))
-		enemy = factory.CreateEnemy(enemyType, x, y)
-	}
+	ep.applyAffixes(enemy, request.Affixes)
 
-	getEnemyStats := ep.getEnemyStats()
+	ep.enemies = append(ep.enemies, enemy)
 
-	enemy.Health = enemy.MaxHealth + (enemy.MaxHealth * getEnemyStats.Health)
-	enemy.Power = enemy.Power + (enemy.Power * getEnemyStats.Damage)
+	// Visible to the overlap checks of the next spawns this frame
+	ep.grid.Insert(enemy, enemy.X, enemy.Y, enemy.Width, enemy.Height)
+}
 
-	ep.enemies = append(ep.enemies, enemy)
+func (ep *EnemyPlugin) countActive() int {
+	count := 0
+
+	for _, enemy := range ep.enemies {
+		if enemy.Active {
+			count++
+		}
+	}
+
+	return count
 }
 
 func (ep *EnemyPlugin) GetEnemies() []*entity.Enemy {
 	return ep.enemies
 }
 
-func (ep *EnemyPlugin) SetEnemies(e []*entity.Enemy) {
-	ep.enemies = e
+// GetBosses returns the bosses currently alive
+func (ep *EnemyPlugin) GetBosses() []*entity.Enemy {
+	var bosses []*entity.Enemy
+
+	for _, enemy := range ep.enemies {
+		if enemy.Active && enemy.Boss != nil {
+			bosses = append(bosses, enemy)
+		}
+	}
+
+	return bosses
 }
 
-func (ep *EnemyPlugin) moveTowardsPlayer(enemy *entity.Enemy, playerX, playerY float64) {
-	// Direction to player
-	dx := playerX - enemy.X
-	dy := playerY - enemy.Y
-	distance := math.Sqrt(dx*dx + dy*dy)
+func (ep *EnemyPlugin) rebuildGrid() {
+	ep.grid.Clear()
 
-	// Define minimum range based on enemy type
-	minRange := 0.0
-	if enemy.Name == "ranged" {
-		minRange = 200.0 // Ranged enemies try to maintain this distance
-	} else {
-		minRange = 10.0 // Melee enemies get closer
+	for _, enemy := range ep.enemies {
+		if enemy.Active {
+			ep.grid.Insert(enemy, enemy.X, enemy.Y, enemy.Width, enemy.Height)
+		}
 	}
+}
+
+// EnemiesInRadius returns the active enemies overlapping the circle
+func (ep *EnemyPlugin) EnemiesInRadius(x, y, radius float64) []*entity.Enemy {
+	return activeOnly(ep.grid.QueryRadius(x, y, radius, nil))
+}
 
-	// Adjust movement based on range
-	if distance > 0 {
-		dx /= distance
-		dy /= distance
+// EnemiesInRect returns the active enemies overlapping the rectangle
+func (ep *EnemyPlugin) EnemiesInRect(x, y, width, height float64) []*entity.Enemy {
+	return activeOnly(ep.grid.QueryRect(x, y, width, height, nil))
+}
 
-		// If ranged and too close, move away from player
-		if enemy.Name == "ranged" && distance < minRange {
-			dx = -dx
-			dy = -dy
+// NearestEnemies returns up to count active enemies ordered by the distance
+// from their center to the point
+func (ep *EnemyPlugin) NearestEnemies(x, y float64, count int) []*entity.Enemy {
+	return ep.grid.Nearest(x, y, count, func(enemy *entity.Enemy) bool {
+		return enemy.Active
+	})
+}
+
+// activeOnly filters in place the enemies killed since the grid was built
+func activeOnly(enemies []*entity.Enemy) []*entity.Enemy {
+	active := enemies[:0]
+
+	for _, enemy := range enemies {
+		if enemy.Active {
+			active = append(active, enemy)
 		}
 	}
 
+	return active
+}
+
+func (ep *EnemyPlugin) SetEnemies(e []*entity.Enemy) {
+	ep.enemies = e
+}
+
+// moveEnemy runs the enemy behavior and moves it, mixed with a separation
+// force to avoid stacking
+func (ep *EnemyPlugin) moveEnemy(enemy *entity.Enemy, ctx *entity.BehaviorContext) {
+	movement := enemy.Behavior.Update(enemy, ctx)
+
+	ep.steer(enemy, &movement)
+
 	// Simple separation to avoid stacking
 	separationX, separationY := 0.0, 0.0
-	for _, other := range ep.enemies {
-		if other != enemy && other.Active {
+	for _, other := range ep.EnemiesInRadius(enemy.X, enemy.Y, separationDistance) {
+		if other != enemy {
 			diffX := enemy.X - other.X
 			diffY := enemy.Y - other.Y
 			dist := math.Sqrt(diffX*diffX + diffY*diffY)
 
-			if dist < 40 { // Minimal separation distance
+			if dist < separationDistance {
 				separationX += diffX * 0.3
 				separationY += diffY * 0.3
 			}
@@ -433,26 +525,65 @@ func (ep *EnemyPlugin) moveTowardsPlayer(enemy *entity.Enemy, playerX, playerY f
 	}
 
 	// Combine movement forces
-	moveX := dx*0.7 + separationX*0.3
-	moveY := dy*0.7 + separationY*0.3
+	moveX := movement.X*0.7 + separationX*0.3
+	moveY := movement.Y*0.7 + separationY*0.3
 
-	// Update position
-	enemy.X += moveX * enemy.Speed * ep.kernel.DeltaTime
-	enemy.Y += moveY * enemy.Speed * ep.kernel.DeltaTime
+	ep.moveBy(enemy,
+		moveX*enemy.Speed*movement.Speed*ep.kernel.DeltaTime,
+		moveY*enemy.Speed*movement.Speed*ep.kernel.DeltaTime)
 }
 
-func (ep *EnemyPlugin) ApplyDamage(enemy *entities.Enemy, damage float64, isCriticalDamage bool) {
-	// Aplicar a armadura para reduzir o dano
-	effectiveDamage := damage
-	enemy.Health -= effectiveDamage
+// Knockback pushes the enemy away from the position by the distance
+func (ep *EnemyPlugin) Knockback(enemy *entity.Enemy, fromX, fromY, distance float64) {
+	dx := enemy.X + enemy.Width/2 - fromX
+	dy := enemy.Y + enemy.Height/2 - fromY
+	length := math.Sqrt(dx*dx + dy*dy)
+
+	if length == 0 {
+		return
+	}
+
+	ep.moveBy(enemy, dx/length*distance, dy/length*distance)
+}
+
+// moveBy moves the enemy blocked by obstacles, keeping its velocity
+func (ep *EnemyPlugin) moveBy(enemy *entity.Enemy, dx, dy float64) {
+	scenarioPlugin := ep.plugins.GetPlugin("ScenarioSystem").(*scenario.ScenarioPlugin)
+
+	x, y := scenarioPlugin.Move(enemy.X, enemy.Y, enemy.Width, enemy.Height, dx, dy)
+
+	// Stuck when an obstacle ate most of the movement
+	intended := math.Sqrt(dx*dx + dy*dy)
+	moved := math.Hypot(x-enemy.X, y-enemy.Y)
+
+	if intended > 0 && moved < intended*stuckMovementRatio {
+		enemy.StuckTime += ep.kernel.DeltaTime
+	} else {
+		enemy.StuckTime = 0
+	}
+
+	enemy.VelocityX = (x - enemy.X) / ep.kernel.DeltaTime
+	enemy.VelocityY = (y - enemy.Y) / ep.kernel.DeltaTime
+	enemy.X, enemy.Y = x, y
+}
+
+// ApplyDamage applies damage already mitigated by the enemy defenses, see
+// damage.Calculate
+func (ep *EnemyPlugin) ApplyDamage(enemy *entities.Enemy, amount float64, isCriticalDamage bool, damageType damage.Type) {
+	effectiveDamage := amount
+
+	// O escudo absorve o dano antes da vida
+	absorbed := math.Min(enemy.Shield, effectiveDamage)
+	enemy.Shield -= absorbed
+	enemy.Health -= effectiveDamage - absorbed
 
 	if enemy.Health <= 0 {
 		enemy.Health = 0
 	}
 
-	colorByType := map[bool]color.Color{
-		true:  color.RGBA{255, 0, 0, 200},
-		false: color.RGBA{255, 255, 255, 200},
+	var damageColor color.Color = damage.Colors[damageType]
+	if absorbed > 0 {
+		damageColor = shieldColor
 	}
 
 	damageText := fmt.Sprintf("%d", int(effectiveDamage))
@@ -464,31 +595,21 @@ func (ep *EnemyPlugin) ApplyDamage(enemy *entities.Enemy, damage float64, isCrit
 	textY := enemy.Y - float64(textHeight)/2
 
 	ep.damages = append(ep.damages, DamageInfo{
-		X:     textX,
-		Y:     textY,
-		Value: effectiveDamage,
-		Color: colorByType[isCriticalDamage],
-		Timer: 0.4,
+		X:        textX,
+		Y:        textY,
+		Value:    effectiveDamage,
+		Color:    damageColor,
+		Timer:    0.4,
+		Critical: isCriticalDamage,
 	})
 
 }
 
 func (ep *EnemyPlugin) AddDeathEnemies(e *entity.Enemy) {
+	e.Dying = true
 	ep.deathEnemies = append(ep.deathEnemies, e)
-}
-
-func (ep *EnemyPlugin) getEnemyStats() EnemyBaseStats {
-	currentMinute := int(ep.gameTimer / 60)
 
-	stats := ep.baseStats[6]
-
-	if baseStats, exists := ep.baseStats[currentMinute]; exists {
-		stats = baseStats
+	if e.IsElite() {
+		ep.eliteDeath(e)
 	}
-
-	return stats
-}
-
-func (ep *EnemyPlugin) GetGlobalProjectiles() []*entity.Projectile {
-	return ep.globalProjectiles
 }
@@ -0,0 +1,80 @@
+package pool
+
+import (
+	"game/internal/plugins/playing/enemy/entities"
+	"game/internal/plugins/playing/enemy/factory"
+	"sort"
+)
+
+// Pool keeps the released enemies by type so they are only reused as the
+// same kind of enemy
+type Pool struct {
+	free map[entities.EnemyType][]*entities.Enemy
+
+	created map[entities.EnemyType]int
+	reused  map[entities.EnemyType]int
+}
+
+// Stats are the pool metrics of one enemy type
+type Stats struct {
+	Type    entities.EnemyType
+	Free    int
+	Created int
+	Reused  int
+}
+
+func New() *Pool {
+	return &Pool{
+		free:    map[entities.EnemyType][]*entities.Enemy{},
+		created: map[entities.EnemyType]int{},
+		reused:  map[entities.EnemyType]int{},
+	}
+}
+
+// Get returns an active enemy of the type at the position, reset through
+// factory.Reset when it comes from the pool
+func (p *Pool) Get(enemyType entities.EnemyType, x, y float64) *entities.Enemy {
+	free := p.free[enemyType]
+
+	if len(free) == 0 {
+		p.created[enemyType]++
+
+		return factory.CreateEnemy(enemyType, x, y)
+	}
+
+	enemy := free[len(free)-1]
+	free[len(free)-1] = nil
+	p.free[enemyType] = free[:len(free)-1]
+
+	factory.Reset(enemy, x, y)
+	p.reused[enemyType]++
+
+	return enemy
+}
+
+// Put releases an enemy, it must not be referenced by the plugin anymore
+func (p *Pool) Put(enemy *entities.Enemy) {
+	enemy.Active = false
+
+	p.free[enemy.Type] = append(p.free[enemy.Type], enemy)
+}
+
+// Stats returns the metrics of every type seen so far, ordered by type
+func (p *Pool) Stats() []Stats {
+	stats := make([]Stats, 0, len(p.created))
+
+	for enemyType, created := range p.created {
+		stats = append(stats, Stats{
+			Type:    enemyType,
+			Free:    len(p.free[enemyType]),
+			Created: created,
+			Reused:  p.reused[enemyType],
+		})
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Type < stats[j].Type
+	})
+
+	return stats
+}
@@ -0,0 +1,177 @@
+package projectiles
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"game/internal/assets"
+	"game/internal/plugins/playing/enemy/entities"
+	"image/color"
+	"path"
+	"strings"
+)
+
+const definitionsPath = "assets/data/projectiles"
+
+// Definitions are loaded from the embedded projectile files by Load, keyed
+// by name
+var Definitions = map[string]*entities.ProjectileDefinition{}
+
+var patterns = map[string]bool{
+	entities.PatternAimed:  true,
+	entities.PatternSpread: true,
+	entities.PatternRing:   true,
+	entities.PatternSpiral: true,
+	entities.PatternBurst:  true,
+}
+
+type definition struct {
+	Name     string  `json:"name"`
+	Size     float64 `json:"size"`
+	Speed    float64 `json:"speed"`
+	Damage   float64 `json:"damage"`
+	Lifetime float64 `json:"lifetime"`
+	Homing   float64 `json:"homing"`
+	Color    [3]int  `json:"color"`
+
+	Sprite struct {
+		Folder     string  `json:"folder"`
+		FrameDelay float64 `json:"frameDelay"`
+	} `json:"sprite"`
+}
+
+// Load reads every projectile definition, validating all of them before
+// failing
+func Load() error {
+	entries, err := assets.ReadDir(definitionsPath)
+	if err != nil {
+		return fmt.Errorf("enemy projectiles: %w", err)
+	}
+
+	var errs []error
+
+	loaded := map[string]*entities.ProjectileDefinition{}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+
+		file := path.Join(definitionsPath, entry.Name())
+
+		projectile, err := loadDefinition(file)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", file, err))
+			continue
+		}
+
+		if _, exists := loaded[projectile.Name]; exists {
+			errs = append(errs, fmt.Errorf("%s: duplicated projectile %q", file, projectile.Name))
+			continue
+		}
+
+		loaded[projectile.Name] = projectile
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("enemy projectiles:\n%w", errors.Join(errs...))
+	}
+
+	Definitions = loaded
+
+	return nil
+}
+
+// ValidatePattern checks an emission pattern referenced by an enemy or boss
+func ValidatePattern(p entities.Pattern) error {
+	var errs []error
+
+	if !patterns[p.Kind] {
+		errs = append(errs, fmt.Errorf("unknown pattern %q", p.Kind))
+	}
+
+	if p.Count <= 0 {
+		errs = append(errs, errors.New("pattern count must be positive"))
+	}
+
+	if p.Angle < 0 || p.Angle > 360 {
+		errs = append(errs, errors.New("pattern angle must be between 0 and 360"))
+	}
+
+	if p.Kind == entities.PatternBurst && (p.Shots <= 0 || p.Interval <= 0) {
+		errs = append(errs, errors.New("burst pattern needs positive shots and interval"))
+	}
+
+	return errors.Join(errs...)
+}
+
+func loadDefinition(file string) (*entities.ProjectileDefinition, error) {
+	data, err := assets.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var d definition
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&d); err != nil {
+		return nil, err
+	}
+
+	if err := d.validate(); err != nil {
+		return nil, err
+	}
+
+	projectile := &entities.ProjectileDefinition{
+		Name:     d.Name,
+		Size:     d.Size,
+		Speed:    d.Speed,
+		Damage:   d.Damage,
+		Lifetime: d.Lifetime,
+		Homing:   d.Homing,
+		Color: color.RGBA{
+			uint8(d.Color[0]), uint8(d.Color[1]), uint8(d.Color[2]), 255,
+		},
+	}
+
+	if d.Sprite.Folder != "" {
+		projectile.Animation, err = assets.LoadAnimationFolder(d.Sprite.Folder, d.Sprite.FrameDelay)
+		if err != nil {
+			return nil, fmt.Errorf("sprite: %w", err)
+		}
+	}
+
+	return projectile, nil
+}
+
+func (d definition) validate() error {
+	var errs []error
+
+	if d.Name == "" {
+		errs = append(errs, errors.New("name is required"))
+	}
+
+	if d.Size <= 0 || d.Speed <= 0 || d.Lifetime <= 0 {
+		errs = append(errs, errors.New("size, speed and lifetime must be positive"))
+	}
+
+	if d.Damage < 0 || d.Homing < 0 {
+		errs = append(errs, errors.New("damage and homing can't be negative"))
+	}
+
+	for _, c := range d.Color {
+		if c < 0 || c > 255 {
+			errs = append(errs, errors.New("color components must be between 0 and 255"))
+			break
+		}
+	}
+
+	if d.Sprite.Folder != "" && d.Sprite.FrameDelay <= 0 {
+		errs = append(errs, errors.New("sprite frameDelay must be positive"))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,176 @@
+package enemy
+
+import (
+	"game/internal/plugins/playing/camera"
+	"game/internal/plugins/playing/enemy/director"
+	"game/internal/plugins/playing/enemy/templates"
+	"game/internal/plugins/playing/scenario"
+	"math"
+	"math/rand"
+)
+
+const (
+	// spawnAttempts is how many ring positions are tried before giving up
+	spawnAttempts = 8
+
+	// travelSpread is the angle around the travel direction used by the
+	// biased spawns
+	travelSpread = math.Pi / 3
+
+	// travelSmoothing weights the latest player movement in the travel
+	// direction
+	travelSmoothing = 0.1
+
+	// formationSpacing is the distance between enemies of a line, relative
+	// to their size
+	formationSpacing = 1.5
+)
+
+// updateTravel smooths the player movement into the direction the spawns
+// are biased toward
+func (ep *EnemyPlugin) updateTravel(playerX, playerY float64) {
+	ep.travelX += (playerX - ep.lastPlayerX - ep.travelX) * travelSmoothing
+	ep.travelY += (playerY - ep.lastPlayerY - ep.travelY) * travelSmoothing
+
+	ep.lastPlayerX, ep.lastPlayerY = playerX, playerY
+}
+
+func (ep *EnemyPlugin) spawnGroup(group director.Group) {
+	switch group.Formation {
+	case director.FormationRing:
+		ep.spawnRing(group.Requests)
+	case director.FormationLine:
+		ep.spawnLine(group.Requests)
+	default:
+		for _, request := range group.Requests {
+			ep.spawn(request)
+		}
+	}
+}
+
+// spawn places the enemy somewhere on the spawn ring, bosses are placed even
+// when no free position was found
+func (ep *EnemyPlugin) spawn(request director.SpawnRequest) {
+	template := templates.EnemyTemplates[request.Type]
+	size := template.Size
+
+	var x, y float64
+
+	for i := 0; i < spawnAttempts; i++ {
+		x, y = ep.ringPosition(ep.spawnAngle(), rand.Float64(), size)
+
+		if ep.isFreeSpawn(x, y, size) {
+			ep.spawnAt(request, x, y)
+			return
+		}
+	}
+
+	if template.Boss != nil {
+		ep.spawnAt(request, x, y)
+		return
+	}
+
+	ep.misplaced++
+}
+
+// spawnRing encircles the player with the enemies, slots on obstacles stay
+// empty
+func (ep *EnemyPlugin) spawnRing(requests []director.SpawnRequest) {
+	offset := rand.Float64() * 2 * math.Pi
+
+	for i, request := range requests {
+		angle := offset + 2*math.Pi*float64(i)/float64(len(requests))
+		size := templates.EnemyTemplates[request.Type].Size
+
+		x, y := ep.ringPosition(angle, 0, size)
+
+		if !ep.isWalkableSpawn(x, y, size) {
+			ep.misplaced++
+			continue
+		}
+
+		ep.spawnAt(request, x, y)
+	}
+}
+
+// spawnLine places the enemies side by side on the spawn ring, across the
+// direction they come from
+func (ep *EnemyPlugin) spawnLine(requests []director.SpawnRequest) {
+	angle := ep.spawnAngle()
+
+	// Perpendicular to the direction of the line from the player
+	dirX, dirY := -math.Sin(angle), math.Cos(angle)
+
+	for i, request := range requests {
+		size := templates.EnemyTemplates[request.Type].Size
+		offset := (float64(i) - float64(len(requests)-1)/2) * size * formationSpacing
+
+		x, y := ep.ringPosition(angle, 0, size)
+		x += dirX * offset
+		y += dirY * offset
+
+		if !ep.isWalkableSpawn(x, y, size) {
+			ep.misplaced++
+			continue
+		}
+
+		ep.spawnAt(request, x, y)
+	}
+}
+
+// spawnAngle returns the angle from the player where the next enemy shows up,
+// ahead of the player movement for a share of the spawns
+func (ep *EnemyPlugin) spawnAngle() float64 {
+	moving := ep.travelX != 0 || ep.travelY != 0
+
+	if moving && rand.Float64() < ep.director.SpawnRing().TravelBias {
+		return math.Atan2(ep.travelY, ep.travelX) + (rand.Float64()*2-1)*travelSpread
+	}
+
+	return rand.Float64() * 2 * math.Pi
+}
+
+// ringPosition returns the top-left position of an enemy of the size on the
+// spawn ring around the camera view, depth goes from 0 at the inner edge to
+// 1 at the outer one, the whole enemy stays inside the cull margin
+func (ep *EnemyPlugin) ringPosition(angle, depth, size float64) (float64, float64) {
+	cameraPlugin := ep.plugins.GetPlugin("CameraSystem").(*camera.CameraPlugin)
+	cameraX, cameraY := cameraPlugin.GetPosition()
+	viewWidth, viewHeight := cameraPlugin.GetViewSize()
+
+	ring := ep.director.SpawnRing()
+	reach := math.Min(ring.Margin+ring.Depth*depth, math.Max(director.CullMargin-size, 0))
+	distance := reach + size/2
+
+	halfWidth := viewWidth/2 + distance
+	halfHeight := viewHeight/2 + distance
+
+	cos, sin := math.Cos(angle), math.Sin(angle)
+
+	// Scale the direction until it reaches the expanded view rectangle
+	scale := math.Inf(1)
+	if cos != 0 {
+		scale = halfWidth / math.Abs(cos)
+	}
+
+	if sin != 0 {
+		scale = math.Min(scale, halfHeight/math.Abs(sin))
+	}
+
+	centerX := cameraX + viewWidth/2 + cos*scale
+	centerY := cameraY + viewHeight/2 + sin*scale
+
+	return centerX - size/2, centerY - size/2
+}
+
+func (ep *EnemyPlugin) isWalkableSpawn(x, y, size float64) bool {
+	scenarioPlugin := ep.plugins.GetPlugin("ScenarioSystem").(*scenario.ScenarioPlugin)
+
+	return scenarioPlugin.IsAreaWalkable(x, y, size, size)
+}
+
+// isFreeSpawn checks the position is walkable and not overlapping enemies,
+// including the ones spawned earlier this frame
+func (ep *EnemyPlugin) isFreeSpawn(x, y, size float64) bool {
+	return ep.isWalkableSpawn(x, y, size) && len(ep.EnemiesInRect(x, y, size, size)) == 0
+}
@@ -1,81 +1,362 @@
 package templates
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"game/internal/assets"
+	"game/internal/plugins/playing/damage"
+	"game/internal/plugins/playing/enemy/behaviors"
 	"game/internal/plugins/playing/enemy/entities"
-	"log"
+	"game/internal/plugins/playing/enemy/projectiles"
+	pickupentities "game/internal/plugins/playing/pickup/entities"
+	"path"
+	"sort"
+	"strings"
 )
 
-const (
-	BasicEnemy entities.EnemyType = iota
-	FastEnemy
-	TankEnemy
-	RangedEnemy
-)
+const definitionsPath = "assets/data/enemies"
 
-var EnemyTemplates = map[entities.EnemyType]*entities.EnemyTemplate{
-	BasicEnemy: {
-		Name:                 "basic",
-		MaxHealth:            30,
-		Speed:                80,
-		Damage:               10,
-		Size:                 40,
-		Power:                10,
-		RunningAnimationTime: 0.3,
-	},
-	FastEnemy: {
-		Name:                 "fast",
-		MaxHealth:            10,
-		Speed:                100,
-		Damage:               5,
-		Size:                 30,
-		Power:                5,
-		RunningAnimationTime: 0.1,
-	},
-	TankEnemy: {
-		Name:                 "tank",
-		MaxHealth:            50,
-		Speed:                50,
-		Damage:               20,
-		Size:                 70,
-		Power:                20,
-		RunningAnimationTime: 0.1,
-	},
-	RangedEnemy: {
-		Name:                 "ranged",
-		MaxHealth:            20,
-		Speed:                75,
-		Damage:               15,
-		Size:                 25,
-		Power:                15,
-		RunningAnimationTime: 0.2,
-	},
+var bossAttacks = map[string]bool{
+	entities.BossAttackCharge: true,
+	entities.BossAttackShoot:  true,
+	entities.BossAttackSummon: true,
 }
 
-func init() {
-	for _, t := range EnemyTemplates {
-		t.RunningLeftAnimationSprite = assets.NewAnimation(t.RunningAnimationTime)
-		err := t.RunningLeftAnimationSprite.LoadFromJSON(
-			"assets/images/enemies/"+t.Name+"/run/left/asset.json",
-			"assets/images/enemies/"+t.Name+"/run/left/asset.png")
-		if err != nil {
-			log.Fatal("Failed to load enemy left run asset:", err)
+// EnemyTemplates are loaded from the embedded enemy definitions by Load
+var EnemyTemplates = map[entities.EnemyType]*entities.EnemyTemplate{}
+
+type definition struct {
+	Name      string  `json:"name"`
+	MaxHealth float64 `json:"maxHealth"`
+	Speed     float64 `json:"speed"`
+	Damage    float64 `json:"damage"`
+	Power     float64 `json:"power"`
+	Size      float64 `json:"size"`
+
+	Armor       float64                 `json:"armor"`
+	Resistances map[damage.Type]float64 `json:"resistances"`
+
+	Sprites struct {
+		RunLeft         string  `json:"runLeft"`
+		RunRight        string  `json:"runRight"`
+		Death           string  `json:"death"`
+		RunFrameDelay   float64 `json:"runFrameDelay"`
+		DeathFrameDelay float64 `json:"deathFrameDelay"`
+	} `json:"sprites"`
+
+	Behavior string `json:"behavior"`
+
+	Attack struct {
+		Cooldown   float64          `json:"cooldown"`
+		Range      float64          `json:"range"`
+		Projectile string           `json:"projectile"`
+		Pattern    entities.Pattern `json:"pattern"`
+	} `json:"attack"`
+
+	Experience int                `json:"experience"`
+	Loot       entities.LootTable `json:"loot"`
+
+	Boss *entities.BossDefinition `json:"boss"`
+}
+
+// Load reads every enemy definition, validating all of them before failing
+// so every problem is reported at once, projectiles must be loaded first
+func Load() error {
+	entries, err := assets.ReadDir(definitionsPath)
+	if err != nil {
+		return fmt.Errorf("enemy templates: %w", err)
+	}
+
+	var errs []error
+
+	templates := map[entities.EnemyType]*entities.EnemyTemplate{}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
 		}
 
-		t.RunningRightAnimationSprite = assets.NewAnimation(t.RunningAnimationTime)
-		err = t.RunningRightAnimationSprite.LoadFromJSON(
-			"assets/images/enemies/"+t.Name+"/run/right/asset.json",
-			"assets/images/enemies/"+t.Name+"/run/right/asset.png")
+		file := path.Join(definitionsPath, entry.Name())
+
+		template, err := loadTemplate(file)
 		if err != nil {
-			log.Fatal("Failed to load enemy run run asset:", err)
+			errs = append(errs, fmt.Errorf("%s: %w", file, err))
+			continue
 		}
 
-		t.DeathAnimation = assets.NewAnimation(0.1)
-		err = t.DeathAnimation.LoadFromJSON(
-			"assets/images/enemies/"+t.Name+"/death/asset.json",
-			"assets/images/enemies/"+t.Name+"/death/asset.png")
-		if err != nil {
-			log.Fatal("Failed to load enemy death asset:", err)
+		enemyType := entities.EnemyType(template.Name)
+		if _, exists := templates[enemyType]; exists {
+			errs = append(errs, fmt.Errorf("%s: duplicated enemy %q", file, template.Name))
+			continue
+		}
+
+		templates[enemyType] = template
+	}
+
+	errs = append(errs, validateSummons(templates)...)
+
+	if len(templates) == 0 && len(errs) == 0 {
+		errs = append(errs, fmt.Errorf("no enemy definitions found in %s", definitionsPath))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("enemy templates:\n%w", errors.Join(errs...))
+	}
+
+	EnemyTemplates = templates
+
+	return nil
+}
+
+// Types returns the loaded enemy types in a stable order
+func Types() []entities.EnemyType {
+	types := make([]entities.EnemyType, 0, len(EnemyTemplates))
+
+	for t := range EnemyTemplates {
+		types = append(types, t)
+	}
+
+	sort.Slice(types, func(i, j int) bool {
+		return types[i] < types[j]
+	})
+
+	return types
+}
+
+func loadTemplate(file string) (*entities.EnemyTemplate, error) {
+	data, err := assets.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var d definition
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&d); err != nil {
+		return nil, err
+	}
+
+	if err := d.validate(); err != nil {
+		return nil, err
+	}
+
+	if d.Boss != nil {
+		for _, phase := range d.Boss.Phases {
+			for i := range phase.Attacks {
+				phase.Attacks[i].ProjectileDefinition = projectiles.Definitions[phase.Attacks[i].Projectile]
+			}
+		}
+	}
+
+	template := &entities.EnemyTemplate{
+		Name:                 d.Name,
+		MaxHealth:            d.MaxHealth,
+		Speed:                d.Speed,
+		Damage:               d.Damage,
+		Size:                 d.Size,
+		Power:                d.Power,
+		Armor:                d.Armor,
+		Resistances:          d.Resistances,
+		Behavior:             d.Behavior,
+		AttackCooldown:       d.Attack.Cooldown,
+		AttackRange:          d.Attack.Range,
+		Projectile:           projectiles.Definitions[d.Attack.Projectile],
+		Pattern:              d.Attack.Pattern,
+		Experience:           d.Experience,
+		Loot:                 d.Loot,
+		Boss:                 d.Boss,
+		RunningAnimationTime: d.Sprites.RunFrameDelay,
+	}
+
+	var errs []error
+
+	template.RunningLeftAnimationSprite, err = assets.LoadAnimationFolder(d.Sprites.RunLeft, d.Sprites.RunFrameDelay)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("run left sprite: %w", err))
+	}
+
+	template.RunningRightAnimationSprite, err = assets.LoadAnimationFolder(d.Sprites.RunRight, d.Sprites.RunFrameDelay)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("run right sprite: %w", err))
+	}
+
+	template.DeathAnimation, err = assets.LoadAnimationFolder(d.Sprites.Death, d.Sprites.DeathFrameDelay)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("death sprite: %w", err))
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return template, nil
+}
+
+func (d definition) validate() error {
+	var errs []error
+
+	if d.Name == "" {
+		errs = append(errs, errors.New("name is required"))
+	}
+
+	if d.MaxHealth <= 0 {
+		errs = append(errs, errors.New("maxHealth must be positive"))
+	}
+
+	if d.Speed < 0 {
+		errs = append(errs, errors.New("speed can't be negative"))
+	}
+
+	if d.Size <= 0 {
+		errs = append(errs, errors.New("size must be positive"))
+	}
+
+	if d.Power < 0 || d.Damage < 0 {
+		errs = append(errs, errors.New("power and damage can't be negative"))
+	}
+
+	if d.Armor < 0 {
+		errs = append(errs, errors.New("armor can't be negative"))
+	}
+
+	for t, resistance := range d.Resistances {
+		if !damage.Exists(t) {
+			errs = append(errs, fmt.Errorf("unknown resistance %q, known damage types are %s",
+				t, strings.Join(damage.Names(), ", ")))
+		}
+
+		if resistance < -1 || resistance > 1 {
+			errs = append(errs, fmt.Errorf("%s resistance must be between -1 and 1", t))
+		}
+	}
+
+	if d.Sprites.RunLeft == "" || d.Sprites.RunRight == "" || d.Sprites.Death == "" {
+		errs = append(errs, errors.New("runLeft, runRight and death sprites are required"))
+	}
+
+	if d.Sprites.RunFrameDelay <= 0 || d.Sprites.DeathFrameDelay <= 0 {
+		errs = append(errs, errors.New("sprite frame delays must be positive"))
+	}
+
+	if !behaviors.Exists(d.Behavior) {
+		errs = append(errs, fmt.Errorf("unknown behavior %q, known behaviors are %s",
+			d.Behavior, strings.Join(behaviors.Names(), ", ")))
+	}
+
+	if d.Attack.Cooldown < 0 || d.Attack.Range < 0 {
+		errs = append(errs, errors.New("attack parameters can't be negative"))
+	}
+
+	if d.Attack.Projectile != "" {
+		if _, exists := projectiles.Definitions[d.Attack.Projectile]; !exists {
+			errs = append(errs, fmt.Errorf("unknown projectile %q", d.Attack.Projectile))
+		}
+
+		if err := projectiles.ValidatePattern(d.Attack.Pattern); err != nil {
+			errs = append(errs, fmt.Errorf("attack: %w", err))
+		}
+	}
+
+	if d.Attack.Cooldown > 0 && d.Attack.Projectile == "" {
+		errs = append(errs, errors.New("attack cooldown is set but there is no projectile"))
+	}
+
+	if d.Experience < 0 {
+		errs = append(errs, errors.New("experience can't be negative"))
+	}
+
+	if d.Loot.Chance < 0 || d.Loot.Chance > 1 {
+		errs = append(errs, errors.New("loot chance must be between 0 and 1"))
+	}
+
+	for i, entry := range d.Loot.Entries {
+		if !pickupentities.Droppable[pickupentities.Kind(entry.Item)] {
+			errs = append(errs, fmt.Errorf("loot entry %d has unknown item %q", i, entry.Item))
+		}
+
+		if entry.Weight <= 0 {
+			errs = append(errs, fmt.Errorf("loot entry %d weight must be positive", i))
+		}
+	}
+
+	if d.Boss != nil {
+		errs = append(errs, validateBoss(d.Boss)...)
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateBoss(boss *entities.BossDefinition) []error {
+	var errs []error
+
+	if len(boss.Phases) == 0 {
+		errs = append(errs, errors.New("boss needs at least one phase"))
+	}
+
+	for i, phase := range boss.Phases {
+		if i == 0 && phase.Threshold != 1 {
+			errs = append(errs, errors.New("first boss phase threshold must be 1"))
+		}
+
+		if i > 0 && phase.Threshold >= boss.Phases[i-1].Threshold {
+			errs = append(errs, fmt.Errorf("boss phase %d threshold must be lower than the previous one", i))
+		}
+
+		if phase.Speed <= 0 {
+			errs = append(errs, fmt.Errorf("boss phase %d speed must be positive", i))
+		}
+
+		for _, attack := range phase.Attacks {
+			if !bossAttacks[attack.Pattern] {
+				errs = append(errs, fmt.Errorf("boss phase %d has unknown attack %q", i, attack.Pattern))
+			}
+
+			if attack.Cooldown <= 0 {
+				errs = append(errs, fmt.Errorf("boss phase %d attack %q cooldown must be positive", i, attack.Pattern))
+			}
+
+			if attack.Pattern != entities.BossAttackShoot {
+				continue
+			}
+
+			if _, exists := projectiles.Definitions[attack.Projectile]; !exists {
+				errs = append(errs, fmt.Errorf("boss phase %d shoots unknown projectile %q", i, attack.Projectile))
+			}
+
+			if err := projectiles.ValidatePattern(attack.Emission); err != nil {
+				errs = append(errs, fmt.Errorf("boss phase %d: %w", i, err))
+			}
+		}
+	}
+
+	return errs
+}
+
+// validateSummons checks the enemies summoned by bosses exist
+func validateSummons(templates map[entities.EnemyType]*entities.EnemyTemplate) []error {
+	var errs []error
+
+	for _, t := range templates {
+		if t.Boss == nil {
+			continue
+		}
+
+		for _, phase := range t.Boss.Phases {
+			for _, attack := range phase.Attacks {
+				if attack.Pattern != entities.BossAttackSummon {
+					continue
+				}
+
+				if _, exists := templates[attack.Summon]; !exists {
+					errs = append(errs, fmt.Errorf("%s summons unknown enemy %q", t.Name, attack.Summon))
+				}
+			}
 		}
 	}
+
+	return errs
 }
@@ -0,0 +1,277 @@
+package hostile
+
+import (
+	"game/internal/assets"
+	"game/internal/constants"
+	"game/internal/core"
+	"game/internal/core/eventbus"
+	"game/internal/helpers/shapes"
+	"game/internal/plugins"
+	"game/internal/plugins/playing/ability"
+	abilityentities "game/internal/plugins/playing/ability/entities/abilities"
+	"game/internal/plugins/playing/camera"
+	"game/internal/plugins/playing/combat"
+	"game/internal/plugins/playing/combat/events"
+	"game/internal/plugins/playing/damage"
+	"game/internal/plugins/playing/enemy/director"
+	enemyentities "game/internal/plugins/playing/enemy/entities"
+	playerentities "game/internal/plugins/playing/player/entities"
+	"game/internal/plugins/playing/scenario"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+var defaultColor = color.RGBA{255, 0, 0, 255}
+
+// HostileProjectilePlugin moves the enemy projectiles and collides them with
+// the terrain, the player and the ability guards
+type HostileProjectilePlugin struct {
+	kernel  *core.GameKernel
+	plugins *core.PluginManager
+
+	subscription eventbus.Subscription
+
+	projectiles []*enemyentities.Projectile
+}
+
+func NewHostileProjectilePlugin(plugins *core.PluginManager) *HostileProjectilePlugin {
+	return &HostileProjectilePlugin{
+		plugins: plugins,
+	}
+}
+
+func (hp *HostileProjectilePlugin) ID() string {
+	return "HostileProjectileSystem"
+}
+
+func (hp *HostileProjectilePlugin) Init(kernel *core.GameKernel) error {
+	hp.kernel = kernel
+	hp.projectiles = []*enemyentities.Projectile{}
+
+	hp.subscription = kernel.EventBus.Subscribe("Shockwave", func(data interface{}) {
+		shockwave := data.(playerentities.Shockwave)
+
+		hp.Destroy(shapes.Circle{X: shockwave.X, Y: shockwave.Y, Radius: shockwave.Radius})
+	})
+
+	return nil
+}
+
+// Close stops receiving the shockwaves once the run ends
+func (hp *HostileProjectilePlugin) Close() {
+	hp.kernel.EventBus.Unsubscribe(hp.subscription)
+}
+
+// Fire adds a projectile shot by an enemy
+func (hp *HostileProjectilePlugin) Fire(p *enemyentities.Projectile) {
+	hp.projectiles = append(hp.projectiles, p)
+}
+
+// Destroy removes the projectiles inside the shape
+func (hp *HostileProjectilePlugin) Destroy(shape shapes.Shape) {
+	for _, p := range hp.projectiles {
+		if p.Active && shapes.Intersects(shape, p.Hitbox()) {
+			p.Active = false
+		}
+	}
+}
+
+// Reflect sends the hostile projectiles inside the shape back, they hit the
+// enemies on behalf of the source
+func (hp *HostileProjectilePlugin) Reflect(shape shapes.Shape, source string) {
+	for _, p := range hp.projectiles {
+		if p.Active && !p.Reflected && shapes.Intersects(shape, p.Hitbox()) {
+			p.Reflected = true
+			p.ReflectedBy = source
+			p.DirectionX, p.DirectionY = -p.DirectionX, -p.DirectionY
+		}
+	}
+}
+
+func (hp *HostileProjectilePlugin) Update() error {
+	deltaTime := hp.kernel.DeltaTime
+
+	playerPlugin := hp.plugins.GetPlugin("PlayerSystem").(plugins.PlayerPlugin)
+	playerX, playerY := playerPlugin.GetPosition()
+
+	cameraPlugin := hp.plugins.GetPlugin("CameraSystem").(*camera.CameraPlugin)
+	cameraX, cameraY := cameraPlugin.GetPosition()
+
+	scenarioPlugin := hp.plugins.GetPlugin("ScenarioSystem").(*scenario.ScenarioPlugin)
+
+	hp.applyGuards()
+
+	animated := map[*enemyentities.ProjectileDefinition]bool{}
+
+	for _, p := range hp.projectiles {
+		if !p.Active {
+			continue
+		}
+
+		if p.Homing > 0 && !p.Reflected {
+			hp.steer(p, playerX, playerY)
+		}
+
+		p.X += p.DirectionX * p.Speed * deltaTime
+		p.Y += p.DirectionY * p.Speed * deltaTime
+		p.Lifetime -= deltaTime
+
+		if p.Lifetime <= 0 ||
+			p.X < cameraX-director.CullMargin ||
+			p.X > cameraX+constants.ScreenWidth+director.CullMargin ||
+			p.Y < cameraY-director.CullMargin ||
+			p.Y > cameraY+constants.ScreenHeight+director.CullMargin {
+
+			p.Active = false
+			continue
+		}
+
+		if !scenarioPlugin.IsTileWalkable(p.X+p.Width/2, p.Y+p.Height/2) {
+			p.Active = false
+			continue
+		}
+
+		if p.Reflected {
+			hp.hitEnemy(p)
+		} else if shapes.Intersects(p.Hitbox(), playerPlugin.GetHitbox()) {
+			playerPlugin.Hit(playerentities.Hit{
+				Source:       p.Source(),
+				Damage:       p.Power,
+				SourceX:      p.X,
+				SourceY:      p.Y,
+				SourceWidth:  p.Width,
+				SourceHeight: p.Height,
+				Knockback:    0.5,
+			})
+
+			p.Active = false
+		}
+
+		if p.Active && p.Definition != nil && p.Definition.Animation != nil && !animated[p.Definition] {
+			animated[p.Definition] = true
+			p.Definition.Animation.Update(deltaTime)
+		}
+	}
+
+	active := hp.projectiles[:0]
+	for _, p := range hp.projectiles {
+		if p.Active {
+			active = append(active, p)
+		}
+	}
+
+	// Drop the references left behind by the compaction
+	clear(hp.projectiles[len(active):])
+	hp.projectiles = active
+
+	hp.kernel.Profiler.Set("projectiles.hostile", float64(len(hp.projectiles)))
+
+	return nil
+}
+
+// applyGuards lets the acquired abilities destroy or reflect the projectiles
+// entering their guards
+func (hp *HostileProjectilePlugin) applyGuards() {
+	abilityPlugin := hp.plugins.GetPlugin("AbilitySystem").(*ability.AbilityPlugin)
+
+	for _, a := range abilityPlugin.GetAcquiredAbilities() {
+		guarding, ok := a.(abilityentities.Guarding)
+		if !ok {
+			continue
+		}
+
+		for _, guard := range guarding.Guards() {
+			if guard.Reflect {
+				hp.Reflect(guard.Shape, a.ID())
+			} else {
+				hp.Destroy(guard.Shape)
+			}
+		}
+	}
+}
+
+// hitEnemy deals the damage of a reflected projectile to the first enemy it
+// touches
+func (hp *HostileProjectilePlugin) hitEnemy(p *enemyentities.Projectile) {
+	enemyPlugin := hp.plugins.GetPlugin("EnemySystem").(plugins.EnemyPlugin)
+	combatPlugin := hp.plugins.GetPlugin("CombatSystem").(*combat.CombatPlugin)
+
+	hitbox := p.Hitbox()
+
+	for _, enemy := range enemyPlugin.EnemiesInRect(p.X, p.Y, p.Width, p.Height) {
+		if !enemy.Active || !shapes.Intersects(hitbox, enemy.Hitbox()) {
+			continue
+		}
+
+		combatPlugin.Deal(events.DamageEvent{
+			Source: p.ReflectedBy,
+			Target: enemy,
+			Amount: p.Power,
+			Type:   damage.Physical,
+			X:      p.X + p.Width/2,
+			Y:      p.Y + p.Height/2,
+		})
+
+		p.Active = false
+
+		return
+	}
+}
+
+// steer turns the projectile toward the player, limited by its homing turn
+// rate
+func (hp *HostileProjectilePlugin) steer(p *enemyentities.Projectile, playerX, playerY float64) {
+	current := math.Atan2(p.DirectionY, p.DirectionX)
+	desired := math.Atan2(playerY-(p.Y+p.Height/2), playerX-(p.X+p.Width/2))
+
+	diff := math.Remainder(desired-current, 2*math.Pi)
+	maxTurn := p.Homing * hp.kernel.DeltaTime
+
+	diff = math.Max(-maxTurn, math.Min(maxTurn, diff))
+
+	p.DirectionX = math.Cos(current + diff)
+	p.DirectionY = math.Sin(current + diff)
+}
+
+func (hp *HostileProjectilePlugin) Draw(screen *ebiten.Image) {
+	cameraPlugin := hp.plugins.GetPlugin("CameraSystem").(*camera.CameraPlugin)
+	cameraX, cameraY := cameraPlugin.GetPosition()
+
+	for _, p := range hp.projectiles {
+		if !p.Active {
+			continue
+		}
+
+		screenX := p.X - cameraX
+		screenY := p.Y - cameraY
+
+		if p.Definition != nil && p.Definition.Animation != nil {
+			p.Definition.Animation.Draw(screen, assets.DrawInput{
+				Width:  p.Width,
+				Height: p.Height,
+				X:      screenX,
+				Y:      screenY,
+			})
+
+			continue
+		}
+
+		projectileColor := defaultColor
+		if p.Definition != nil {
+			projectileColor = p.Definition.Color
+		}
+
+		vector.DrawFilledRect(
+			screen,
+			float32(screenX),
+			float32(screenY),
+			float32(p.Width),
+			float32(p.Height),
+			projectileColor,
+			true,
+		)
+	}
+}
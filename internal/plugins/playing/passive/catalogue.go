@@ -0,0 +1,108 @@
+package passive
+
+import (
+	"game/internal/plugins/playing/passive/entities"
+	"game/internal/plugins/playing/player/attributes"
+	playerentities "game/internal/plugins/playing/player/entities"
+)
+
+// Catalogue returns a fresh copy of every passive item
+func Catalogue() []*entities.Item {
+	return []*entities.Item{
+		{
+			ID:          "ArmorPlate",
+			Name:        "Armor Plate",
+			Description: "+3% armor",
+			MaxLevel:    5,
+			Effects: []entities.Effect{
+				{Attribute: attributes.Armor, Kind: attributes.Additive, Value: 3},
+			},
+		},
+		{
+			ID:          "Wings",
+			Name:        "Wings",
+			Description: "+10% speed",
+			MaxLevel:    5,
+			Effects: []entities.Effect{
+				{Attribute: attributes.Speed, Kind: attributes.Multiplicative, Value: 0.1},
+			},
+		},
+		{
+			ID:          "Magnet",
+			Name:        "Magnet",
+			Description: "+25 pickup radius",
+			MaxLevel:    5,
+			Effects: []entities.Effect{
+				{Attribute: attributes.PickupRadius, Kind: attributes.Additive, Value: 25},
+			},
+		},
+		{
+			ID:          "Tome",
+			Name:        "Empty Tome",
+			Description: "+8% cooldown reduction",
+			MaxLevel:    5,
+			Effects: []entities.Effect{
+				{Attribute: attributes.CooldownReduction, Kind: attributes.Additive, Value: 8},
+			},
+		},
+		{
+			ID:          "Duplicator",
+			Name:        "Duplicator",
+			Description: "+1 projectile",
+			MaxLevel:    2,
+			Effects: []entities.Effect{
+				{Attribute: attributes.ProjectileCount, Kind: attributes.Additive, Value: 1},
+			},
+		},
+		{
+			ID:          "Candelabrador",
+			Name:        "Candelabrador",
+			Description: "+10% area",
+			MaxLevel:    5,
+			Effects: []entities.Effect{
+				{Attribute: attributes.Area, Kind: attributes.Additive, Value: 0.1},
+			},
+		},
+		{
+			ID:          "Spinach",
+			Name:        "Spinach",
+			Description: "+10% damage",
+			MaxLevel:    5,
+			Effects: []entities.Effect{
+				{Attribute: attributes.DamagePercent, Kind: attributes.Additive, Value: 10},
+			},
+		},
+		{
+			ID:          "HollowHeart",
+			Name:        "Hollow Heart",
+			Description: "+20% max health",
+			MaxLevel:    5,
+			Effects: []entities.Effect{
+				{Attribute: attributes.MaxHealth, Kind: attributes.Multiplicative, Value: 0.2},
+			},
+		},
+		{
+			ID:          "Clover",
+			Name:        "Clover",
+			Description: "+5% critical chance",
+			MaxLevel:    5,
+			Effects: []entities.Effect{
+				{Attribute: attributes.CriticalChance, Kind: attributes.Additive, Value: 5},
+			},
+		},
+		{
+			ID:          "FeatherBoots",
+			Name:        "Feather Boots",
+			Description: "-0.15s dash recharge",
+			MaxLevel:    3,
+			Dash:        playerentities.DashUpgrade{Cooldown: 0.15},
+		},
+		{
+			ID:          "PhoenixFeather",
+			Name:        "Phoenix Feather",
+			Description: "+1 revive",
+			MaxLevel:    2,
+			Revives:     1,
+		},
+	}
+}
@@ -0,0 +1,48 @@
+package entities
+
+import (
+	"game/internal/plugins/playing/player/attributes"
+	playerentities "game/internal/plugins/playing/player/entities"
+)
+
+// Effect is applied once per item level, a level 3 item with Value 5 adds 15
+type Effect struct {
+	Attribute attributes.Attribute
+	Kind      attributes.Kind
+	Value     float64
+}
+
+type Item struct {
+	ID          string
+	Name        string
+	Description string
+
+	Level    int
+	MaxLevel int
+
+	Effects []Effect
+
+	// Dash upgrade and revives granted every time the item is acquired
+	Dash    playerentities.DashUpgrade
+	Revives int
+}
+
+func (i *Item) IsMaxLevel() bool {
+	return i.Level >= i.MaxLevel
+}
+
+// Modifiers returns the attribute modifiers for the current item level
+func (i *Item) Modifiers() []attributes.Modifier {
+	modifiers := make([]attributes.Modifier, 0, len(i.Effects))
+
+	for _, e := range i.Effects {
+		modifiers = append(modifiers, attributes.Modifier{
+			Source:    i.Name,
+			Attribute: e.Attribute,
+			Kind:      e.Kind,
+			Value:     e.Value * float64(i.Level),
+		})
+	}
+
+	return modifiers
+}
@@ -0,0 +1,89 @@
+package passive
+
+import (
+	"game/internal/core"
+	"game/internal/plugins"
+	"game/internal/plugins/playing/passive/entities"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+type PassivePlugin struct {
+	kernel  *core.GameKernel
+	plugins *core.PluginManager
+
+	catalogue []*entities.Item
+	items     map[string]*entities.Item
+	acquired  []*entities.Item
+}
+
+func NewPassivePlugin(plugins *core.PluginManager) *PassivePlugin {
+	return &PassivePlugin{
+		plugins: plugins,
+	}
+}
+
+func (pp *PassivePlugin) ID() string {
+	return "PassiveSystem"
+}
+
+func (pp *PassivePlugin) Init(kernel *core.GameKernel) error {
+	pp.kernel = kernel
+	pp.items = make(map[string]*entities.Item)
+	pp.acquired = []*entities.Item{}
+	pp.catalogue = Catalogue()
+
+	for _, item := range pp.catalogue {
+		pp.items[item.ID] = item
+	}
+
+	return nil
+}
+
+func (pp *PassivePlugin) Update() error {
+	return nil
+}
+
+func (pp *PassivePlugin) Draw(*ebiten.Image) {
+}
+
+// Acquire adds the item or increases its level, re-applying its modifiers to
+// the player
+func (pp *PassivePlugin) Acquire(id string) {
+	item, exists := pp.items[id]
+	if !exists || item.IsMaxLevel() {
+		return
+	}
+
+	if item.Level == 0 {
+		pp.acquired = append(pp.acquired, item)
+	}
+
+	item.Level++
+
+	playerPlugin := pp.plugins.GetPlugin("PlayerSystem").(plugins.PlayerPlugin)
+
+	for _, m := range item.Modifiers() {
+		playerPlugin.AddModifier(m)
+	}
+
+	playerPlugin.UpgradeDash(item.Dash)
+	playerPlugin.AddRevives(item.Revives)
+}
+
+func (pp *PassivePlugin) GetAcquiredItems() []*entities.Item {
+	return pp.acquired
+}
+
+// GetAvailableItems returns the items that can still be acquired or leveled
+func (pp *PassivePlugin) GetAvailableItems() []*entities.Item {
+	var available []*entities.Item
+
+	for _, item := range pp.catalogue {
+		if !item.IsMaxLevel() {
+			available = append(available, item)
+		}
+	}
+
+	return available
+}
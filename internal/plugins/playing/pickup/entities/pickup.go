@@ -0,0 +1,51 @@
+package entities
+
+import "game/internal/assets"
+
+type Kind string
+
+const (
+	// Gem gives its value as experience
+	Gem Kind = "gem"
+
+	// Food heals the player
+	Food Kind = "food"
+
+	// Gold is counted for the run
+	Gold Kind = "gold"
+
+	// Magnet pulls every gem on the field to the player
+	Magnet Kind = "magnet"
+
+	// Bomb releases a shockwave clearing the screen
+	Bomb Kind = "bomb"
+
+	// Chest opens the reward choice
+	Chest Kind = "chest"
+)
+
+// Droppable are the kinds loot tables can drop, gems are dropped from the
+// enemy experience instead
+var Droppable = map[Kind]bool{
+	Food:   true,
+	Gold:   true,
+	Magnet: true,
+	Bomb:   true,
+	Chest:  true,
+}
+
+type Pickup struct {
+	Kind Kind
+
+	X, Y          float64
+	Width, Height float64
+	Active        bool
+	Speed         float64
+	Value         int
+
+	// Attracted pickups fly to the player wherever they are
+	Attracted bool
+
+	// Animation is nil for the pickups drawn as a plain shape
+	Animation *assets.Animation
+}
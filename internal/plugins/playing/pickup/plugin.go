@@ -0,0 +1,365 @@
+package pickup
+
+import (
+	"game/internal/assets"
+	"game/internal/constants"
+	"game/internal/core"
+	"game/internal/plugins/playing/camera"
+	"game/internal/plugins/playing/pickup/entities"
+	"image/color"
+	"math"
+	"math/rand"
+	"time"
+
+	"log"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	plugins "game/internal/plugins"
+)
+
+const (
+	// attractSpeed is the speed of the pickups flying to the player
+	attractSpeed = 450
+
+	// foodHeal is the share of the max health restored by food
+	foodHeal = 0.2
+)
+
+// gemTier is the look of the gems from a minimum value up
+type gemTier struct {
+	minValue int
+	size     float64
+	super    bool
+}
+
+var gemTiers = []gemTier{
+	{minValue: 1, size: 10},
+	{minValue: 5, size: 13},
+	{minValue: 20, size: 15, super: true},
+}
+
+var pickupSizes = map[entities.Kind]float64{
+	entities.Food:   14,
+	entities.Gold:   10,
+	entities.Magnet: 16,
+	entities.Bomb:   16,
+	entities.Chest:  24,
+}
+
+var pickupColors = map[entities.Kind]color.RGBA{
+	entities.Gem:    {0, 255, 255, 255},
+	entities.Food:   {220, 60, 60, 255},
+	entities.Gold:   {255, 215, 0, 255},
+	entities.Magnet: {80, 140, 255, 255},
+	entities.Bomb:   {60, 60, 60, 255},
+	entities.Chest:  {255, 200, 40, 255},
+}
+
+var outlineColor = color.RGBA{120, 70, 20, 255}
+
+type PickupPlugin struct {
+	kernel  *core.GameKernel
+	pickups []*entities.Pickup
+	plugins *core.PluginManager
+
+	gold int
+
+	crystalAnimation      *assets.Animation
+	superCrystalAnimation *assets.Animation
+}
+
+func NewPickupPlugin(plugins *core.PluginManager) *PickupPlugin {
+	return &PickupPlugin{
+		pickups: []*entities.Pickup{},
+		plugins: plugins,
+	}
+}
+
+func (pp *PickupPlugin) ID() string {
+	return "PickupSystem"
+}
+
+func (pp *PickupPlugin) Init(kernel *core.GameKernel) error {
+	pp.kernel = kernel
+	rand.Seed(time.Now().UnixNano())
+
+	crystalAnimation := assets.NewAnimation(0.3)
+	err := crystalAnimation.LoadFromJSON(
+		"assets/images/experience/crystal/asset.json",
+		"assets/images/experience/crystal/asset.png")
+	if err != nil {
+		log.Fatal("Failed to load crystal animation:", err)
+	}
+
+	superCrystalAnimation := assets.NewAnimation(0.3)
+	err = superCrystalAnimation.LoadFromJSON(
+		"assets/images/experience/supercrystal/asset.json",
+		"assets/images/experience/supercrystal/asset.png")
+	if err != nil {
+		log.Fatal("Failed to load super crystal animation:", err)
+	}
+
+	pp.crystalAnimation = crystalAnimation
+	pp.superCrystalAnimation = superCrystalAnimation
+
+	return nil
+}
+
+func (pp *PickupPlugin) Update() error {
+	playerPlugin := pp.plugins.GetPlugin("PlayerSystem").(plugins.PlayerPlugin)
+	playerX, playerY := playerPlugin.GetPosition()
+	playerWidth, playerHeight := playerPlugin.GetSize()
+
+	cameraPlugin := pp.plugins.GetPlugin("CameraSystem").(*camera.CameraPlugin)
+	cameraX, cameraY := cameraPlugin.GetPosition()
+
+	// Group far gems
+	farGems := make([]*entities.Pickup, 0)
+	activePickups := make([]*entities.Pickup, 0)
+
+	for _, pickup := range pp.pickups {
+		if !pickup.Active {
+			continue
+		}
+
+		// Calculate screen position
+		screenX := pickup.X - cameraX
+		screenY := pickup.Y - cameraY
+
+		// Check if the gem is far from screen, other pickups and the gems
+		// flying to the player are never grouped
+		far := screenX < -500 || screenX > constants.ScreenWidth+500 ||
+			screenY < -500 || screenY > constants.ScreenHeight+500
+
+		if far && pickup.Kind == entities.Gem && !pickup.Attracted {
+			farGems = append(farGems, pickup)
+		} else {
+			activePickups = append(activePickups, pickup)
+		}
+	}
+
+	// Create a super gem if enough far gems
+	if len(farGems) >= 5 {
+		totalXP := 0
+		for _, gem := range farGems {
+			totalXP += gem.Value
+		}
+
+		margin := float64(constants.ScreenHeight)
+		angle := rand.Float64() * 2 * math.Pi
+		distanceX := margin
+		distanceY := margin
+
+		superX := playerX + math.Cos(angle)*distanceX
+		superY := playerY + math.Sin(angle)*distanceY
+
+		// Deactivate grouped gems
+		for _, gem := range farGems {
+			gem.Active = false
+		}
+
+		pp.pickups = activePickups
+
+		pp.DropGem(superX, superY, totalXP)
+	}
+
+	// The animations are shared by the pickups, each one runs once per frame
+	animated := map[*assets.Animation]bool{}
+
+	// Update remaining pickups
+	for _, pickup := range pp.pickups {
+		if !pickup.Active {
+			continue
+		}
+
+		if pickup.Animation != nil && !animated[pickup.Animation] {
+			animated[pickup.Animation] = true
+			pickup.Animation.Update(pp.kernel.DeltaTime)
+		}
+
+		// Move towards player if in range
+		dx := (playerX + playerWidth/2) - pickup.X
+		dy := (playerY + playerHeight/2) - pickup.Y
+		distance := math.Sqrt(dx*dx + dy*dy)
+
+		if distance > 0 {
+			dx /= distance
+			dy /= distance
+		}
+
+		pickup.X += dx * pickup.Speed * pp.kernel.DeltaTime
+		pickup.Y += dy * pickup.Speed * pp.kernel.DeltaTime
+
+		if pp.inPlayerCollectionRadius(
+			pickup,
+			playerX, playerY,
+			playerWidth, playerHeight,
+			playerPlugin.GetCollectionRadius()) {
+
+			pp.attract(pickup)
+		}
+
+		if pp.checkCollisionWithPlayer(
+			pickup, playerX, playerY, playerWidth, playerHeight) {
+
+			pickup.Active = false
+
+			pp.collect(pickup, playerPlugin)
+		}
+	}
+
+	// Drop the collected pickups
+	active := pp.pickups[:0]
+	for _, pickup := range pp.pickups {
+		if pickup.Active {
+			active = append(active, pickup)
+		}
+	}
+
+	clear(pp.pickups[len(active):])
+	pp.pickups = active
+
+	return nil
+}
+
+// collect applies the effect of the pickup the player just touched
+func (pp *PickupPlugin) collect(pickup *entities.Pickup, playerPlugin plugins.PlayerPlugin) {
+	switch pickup.Kind {
+	case entities.Gem:
+		playerPlugin.AddExperience(pickup.Value)
+	case entities.Food:
+		playerPlugin.Heal(playerPlugin.GetMaxHealth() * foodHeal)
+	case entities.Gold:
+		pp.gold += pickup.Value
+	case entities.Magnet:
+		for _, gem := range pp.pickups {
+			if gem.Active && gem.Kind == entities.Gem {
+				pp.attract(gem)
+			}
+		}
+	case entities.Bomb:
+		playerPlugin.Shockwave()
+	case entities.Chest:
+		pp.kernel.EventBus.Publish("ChoosingAbility", nil)
+	}
+}
+
+// attract makes the pickup fly to the player until collected
+func (pp *PickupPlugin) attract(pickup *entities.Pickup) {
+	pickup.Attracted = true
+	pickup.Speed = attractSpeed
+}
+
+func (pp *PickupPlugin) Draw(screen *ebiten.Image) {
+	cameraPlugin := pp.plugins.GetPlugin("CameraSystem").(*camera.CameraPlugin)
+	cameraX, cameraY := cameraPlugin.GetPosition()
+
+	for _, pickup := range pp.pickups {
+		if pickup.Active {
+			screenX := pickup.X - cameraX
+			screenY := pickup.Y - cameraY
+
+			// Only draw if on screen (with margin)
+			if screenX >= -pickup.Width && screenX <= constants.ScreenWidth+pickup.Width &&
+				screenY >= -pickup.Height && screenY <= constants.ScreenHeight+pickup.Height {
+
+				if pickup.Animation != nil {
+					pickup.Animation.Draw(screen, assets.DrawInput{
+						Width:  pickup.Width,
+						Height: pickup.Height,
+						X:      screenX,
+						Y:      screenY,
+					})
+
+					continue
+				}
+
+				vector.DrawFilledRect(screen,
+					float32(screenX),
+					float32(screenY),
+					float32(pickup.Width),
+					float32(pickup.Height),
+					pickupColors[pickup.Kind],
+					true)
+
+				if pickup.Kind == entities.Chest {
+					vector.StrokeRect(screen,
+						float32(screenX),
+						float32(screenY),
+						float32(pickup.Width),
+						float32(pickup.Height),
+						2,
+						outlineColor,
+						true)
+				}
+			}
+		}
+	}
+}
+
+// DropGem drops an experience gem, its tier depends on the value
+func (pp *PickupPlugin) DropGem(x, y float64, value int) {
+	tier := gemTiers[0]
+	for _, t := range gemTiers {
+		if value >= t.minValue {
+			tier = t
+		}
+	}
+
+	animation := pp.crystalAnimation
+	if tier.super {
+		animation = pp.superCrystalAnimation
+	}
+
+	pp.pickups = append(pp.pickups, &entities.Pickup{
+		Kind:      entities.Gem,
+		X:         x - (tier.size / 2),
+		Y:         y - (tier.size / 2),
+		Width:     tier.size,
+		Height:    tier.size,
+		Active:    true,
+		Value:     value,
+		Animation: animation,
+	})
+}
+
+// Drop drops a pickup of the kind centered at the position
+func (pp *PickupPlugin) Drop(kind entities.Kind, x, y float64) {
+	size := pickupSizes[kind]
+
+	pp.pickups = append(pp.pickups, &entities.Pickup{
+		Kind:   kind,
+		X:      x - (size / 2),
+		Y:      y - (size / 2),
+		Width:  size,
+		Height: size,
+		Active: true,
+		Value:  1,
+	})
+}
+
+// GetGold returns the gold collected during the run
+func (pp *PickupPlugin) GetGold() int {
+	return pp.gold
+}
+
+func (pp *PickupPlugin) inPlayerCollectionRadius(
+	pickup *entities.Pickup,
+	playerX, playerY, playerWidth, playerHeight, radius float64) bool {
+
+	dx := (playerX + playerWidth/2) - (pickup.X + pickup.Width)
+	dy := (playerY + playerHeight/2) - (pickup.Y + pickup.Height)
+
+	distance := math.Sqrt(dx*dx + dy*dy)
+
+	return distance <= radius
+}
+
+func (pp *PickupPlugin) checkCollisionWithPlayer(pickup *entities.Pickup, playerX, playerY, playerWidth, playerHeight float64) bool {
+	return pickup.X < playerX+playerWidth &&
+		pickup.X+pickup.Width > playerX &&
+		pickup.Y < playerY+playerHeight &&
+		pickup.Y+pickup.Height > playerY
+}
@@ -0,0 +1,187 @@
+package attributes
+
+import "math"
+
+type Attribute int
+
+const (
+	MaxHealth Attribute = iota
+	Armor
+	Speed
+	CriticalChance
+	CriticalMultiplier
+	DamagePercent
+	PickupRadius
+	CooldownReduction
+	Area
+	Duration
+	ProjectileCount
+)
+
+// All attributes in display order
+var All = []Attribute{
+	MaxHealth,
+	Armor,
+	Speed,
+	CriticalChance,
+	CriticalMultiplier,
+	DamagePercent,
+	PickupRadius,
+	CooldownReduction,
+	Area,
+	Duration,
+	ProjectileCount,
+}
+
+var names = map[Attribute]string{
+	MaxHealth:          "Max Health",
+	Armor:              "Armor",
+	Speed:              "Speed",
+	CriticalChance:     "Critical Chance",
+	CriticalMultiplier: "Critical Multiplier",
+	DamagePercent:      "Damage",
+	PickupRadius:       "Pickup Radius",
+	CooldownReduction:  "Cooldown Reduction",
+	Area:               "Area",
+	Duration:           "Duration",
+	ProjectileCount:    "Projectile Count",
+}
+
+func (a Attribute) String() string {
+	return names[a]
+}
+
+type Kind int
+
+const (
+	Additive Kind = iota
+	Multiplicative
+)
+
+// Modifier changes one attribute on behalf of a source (level, item, buff).
+// Additive values are summed to the base, multiplicative values are
+// percentages where 0.2 means +20%.
+type Modifier struct {
+	Source    string
+	Attribute Attribute
+	Kind      Kind
+	Value     float64
+
+	// Duration in seconds, 0 keeps the modifier until its source is removed
+	Duration float64
+
+	remaining float64
+}
+
+func (m *Modifier) Remaining() float64 {
+	return m.remaining
+}
+
+// Set computes every attribute from its base value and the active
+// modifiers. Stacking rules:
+//   - a modifier replaces the one with the same source, attribute and kind
+//   - modifiers from different sources stack
+//   - value = (base + sum of additives) * product of (1 + multiplicatives)
+type Set struct {
+	base      map[Attribute]float64
+	modifiers []*Modifier
+}
+
+func NewSet(base map[Attribute]float64) *Set {
+	s := &Set{base: make(map[Attribute]float64)}
+
+	for a, v := range base {
+		s.base[a] = v
+	}
+
+	return s
+}
+
+func (s *Set) Base(a Attribute) float64 {
+	return s.base[a]
+}
+
+func (s *Set) SetBase(a Attribute, value float64) {
+	s.base[a] = value
+}
+
+func (s *Set) Value(a Attribute) float64 {
+	additive := 0.0
+	multiplier := 1.0
+
+	for _, m := range s.modifiers {
+		if m.Attribute != a {
+			continue
+		}
+
+		switch m.Kind {
+		case Additive:
+			additive += m.Value
+		case Multiplicative:
+			multiplier *= 1 + m.Value
+		}
+	}
+
+	return math.Max(0, (s.base[a]+additive)*multiplier)
+}
+
+func (s *Set) AddModifier(m Modifier) {
+	m.remaining = m.Duration
+
+	for i, current := range s.modifiers {
+		if current.Source == m.Source &&
+			current.Attribute == m.Attribute &&
+			current.Kind == m.Kind {
+
+			s.modifiers[i] = &m
+			return
+		}
+	}
+
+	s.modifiers = append(s.modifiers, &m)
+}
+
+func (s *Set) RemoveSource(source string) {
+	modifiers := s.modifiers[:0]
+
+	for _, m := range s.modifiers {
+		if m.Source != source {
+			modifiers = append(modifiers, m)
+		}
+	}
+
+	s.modifiers = modifiers
+}
+
+// Update expires timed modifiers
+func (s *Set) Update(deltaTime float64) {
+	modifiers := s.modifiers[:0]
+
+	for _, m := range s.modifiers {
+		if m.Duration > 0 {
+			m.remaining -= deltaTime
+
+			if m.remaining <= 0 {
+				continue
+			}
+		}
+
+		modifiers = append(modifiers, m)
+	}
+
+	s.modifiers = modifiers
+}
+
+// Breakdown returns the modifiers currently affecting the attribute, in the
+// order they were added
+func (s *Set) Breakdown(a Attribute) []Modifier {
+	var breakdown []Modifier
+
+	for _, m := range s.modifiers {
+		if m.Attribute == a {
+			breakdown = append(breakdown, *m)
+		}
+	}
+
+	return breakdown
+}
@@ -0,0 +1,167 @@
+package player
+
+import (
+	"game/internal/plugins/playing/player/entities"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const minDashCooldown = 0.2
+
+type afterimage struct {
+	X, Y  float64
+	Frame *ebiten.Image
+	Timer float64
+}
+
+// Dash holds the dash charges, each spent charge recharges on its own timer,
+// and the afterimage trail left while dashing.
+type Dash struct {
+	Speed      float64
+	Duration   float64
+	Cooldown   float64
+	MaxCharges int
+
+	charges   int
+	recharges []float64
+
+	timer      float64
+	directionX float64
+	directionY float64
+
+	afterimageInterval float64
+	afterimageLifetime float64
+	afterimageTimer    float64
+	afterimages        []afterimage
+}
+
+func NewDash(speed, duration, cooldown float64, charges int) *Dash {
+	return &Dash{
+		Speed:      speed,
+		Duration:   duration,
+		Cooldown:   cooldown,
+		MaxCharges: charges,
+		charges:    charges,
+
+		afterimageInterval: 0.03,
+		afterimageLifetime: 0.25,
+	}
+}
+
+// Start spends a charge and dashes towards the given direction
+func (d *Dash) Start(directionX, directionY float64) bool {
+	if d.charges <= 0 || d.IsDashing() {
+		return false
+	}
+
+	length := math.Sqrt(directionX*directionX + directionY*directionY)
+	if length == 0 {
+		return false
+	}
+
+	d.charges--
+	d.recharges = append(d.recharges, d.Cooldown)
+
+	d.timer = d.Duration
+	d.directionX = directionX / length
+	d.directionY = directionY / length
+	d.afterimageTimer = 0
+
+	return true
+}
+
+func (d *Dash) Update(deltaTime float64) {
+	if d.timer > 0 {
+		d.timer -= deltaTime
+	}
+
+	for i := len(d.recharges) - 1; i >= 0; i-- {
+		d.recharges[i] -= deltaTime
+
+		if d.recharges[i] <= 0 {
+			d.recharges = append(d.recharges[:i], d.recharges[i+1:]...)
+			d.charges = min(d.charges+1, d.MaxCharges)
+		}
+	}
+
+	for i := len(d.afterimages) - 1; i >= 0; i-- {
+		d.afterimages[i].Timer -= deltaTime
+
+		if d.afterimages[i].Timer <= 0 {
+			d.afterimages = append(d.afterimages[:i], d.afterimages[i+1:]...)
+		}
+	}
+}
+
+// Trail leaves an afterimage of the given frame every interval while dashing
+func (d *Dash) Trail(deltaTime, x, y float64, frame *ebiten.Image) {
+	if !d.IsDashing() || frame == nil {
+		return
+	}
+
+	d.afterimageTimer -= deltaTime
+	if d.afterimageTimer > 0 {
+		return
+	}
+
+	d.afterimageTimer = d.afterimageInterval
+	d.afterimages = append(d.afterimages, afterimage{
+		X:     x,
+		Y:     y,
+		Frame: frame,
+		Timer: d.afterimageLifetime,
+	})
+}
+
+func (d *Dash) IsDashing() bool {
+	return d.timer > 0
+}
+
+func (d *Dash) Direction() (float64, float64) {
+	return d.directionX, d.directionY
+}
+
+func (d *Dash) Charges() (int, int) {
+	return d.charges, d.MaxCharges
+}
+
+// RechargeProgress returns how close the next charge is from being ready,
+// from 0 to 1. It is 1 when every charge is available.
+func (d *Dash) RechargeProgress() float64 {
+	if len(d.recharges) == 0 || d.Cooldown <= 0 {
+		return 1
+	}
+
+	remaining := d.recharges[0]
+	for _, r := range d.recharges {
+		remaining = math.Min(remaining, r)
+	}
+
+	return 1 - remaining/d.Cooldown
+}
+
+func (d *Dash) Upgrade(u entities.DashUpgrade) {
+	d.MaxCharges += u.Charges
+	d.charges += u.Charges
+	d.Cooldown = math.Max(minDashCooldown, d.Cooldown-u.Cooldown)
+	d.Duration += u.Duration
+	d.Speed += u.Speed
+}
+
+func (d *Dash) Draw(screen *ebiten.Image, cameraX, cameraY, width, height float64) {
+	for _, a := range d.afterimages {
+		op := &ebiten.DrawImageOptions{}
+
+		op.GeoM.Scale(
+			width/float64(a.Frame.Bounds().Dx()),
+			height/float64(a.Frame.Bounds().Dy()))
+
+		op.GeoM.Translate(a.X-cameraX-width/2, a.Y-cameraY-height/2)
+
+		op.ColorScale.Scale(0.6, 0.8, 1, 1)
+		op.ColorScale.ScaleAlpha(float32(a.Timer / d.afterimageLifetime * 0.6))
+
+		screen.DrawImage(a.Frame, op)
+	}
+}
@@ -11,6 +11,7 @@ type Character struct {
 	Armor          float64
 	DamagePercent  float64
 	CriticalChance float64
+	Revives        int
 
 	HealthRegenRate  float64
 	HealthRegenDelay float64
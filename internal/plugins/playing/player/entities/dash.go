@@ -0,0 +1,9 @@
+package entities
+
+// DashUpgrade is added on top of the current dash, granted by levels or items
+type DashUpgrade struct {
+	Charges  int
+	Cooldown float64 // Seconds removed from each charge recharge
+	Duration float64
+	Speed    float64
+}
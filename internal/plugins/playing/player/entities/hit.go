@@ -0,0 +1,20 @@
+package entities
+
+// Hit describes something hurting the player. Source is the attacker bounds
+// (top-left based, like enemies and projectiles) so the player can be pushed
+// away from it.
+type Hit struct {
+	// Source names the attacker in the PlayerHurt event
+	Source string
+
+	Damage float64
+
+	SourceX, SourceY          float64
+	SourceWidth, SourceHeight float64
+
+	// Knockback scales the player knockback force, 0 disables it
+	Knockback float64
+
+	// Solid attackers (enemy bodies) push the player out of their bounds
+	Solid bool
+}
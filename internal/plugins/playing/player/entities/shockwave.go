@@ -0,0 +1,8 @@
+package entities
+
+// Shockwave is published when the player revives or picks a bomb up,
+// everything hostile inside the radius is cleared
+type Shockwave struct {
+	X, Y   float64
+	Radius float64
+}
@@ -2,21 +2,24 @@ package player
 
 import "github.com/hajimehoshi/ebiten/v2"
 
-func InputHandler(p *PlayerPlugin, newX, newY, currentspeed float64) (float64, float64) {
+// InputHandler returns the movement direction pressed by the player
+func InputHandler(p *PlayerPlugin) (float64, float64) {
+	directionX, directionY := 0.0, 0.0
+
 	if ebiten.IsKeyPressed(ebiten.KeyW) {
-		newY -= currentspeed * p.kernel.DeltaTime
+		directionY -= 1
 	}
 	if ebiten.IsKeyPressed(ebiten.KeyS) {
-		newY += currentspeed * p.kernel.DeltaTime
+		directionY += 1
 	}
 	if ebiten.IsKeyPressed(ebiten.KeyA) {
-		newX -= currentspeed * p.kernel.DeltaTime
+		directionX -= 1
 		p.facingRight = false
 	}
 	if ebiten.IsKeyPressed(ebiten.KeyD) {
-		newX += currentspeed * p.kernel.DeltaTime
+		directionX += 1
 		p.facingRight = true
 	}
 
-	return newX, newY
+	return directionX, directionY
 }
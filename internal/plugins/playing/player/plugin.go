@@ -5,13 +5,18 @@ import (
 	"game/internal/config"
 	"game/internal/constants"
 	"game/internal/core"
+	"game/internal/helpers/shapes"
 	"game/internal/plugins/playing/camera"
+	"game/internal/plugins/playing/combat/events"
+	"game/internal/plugins/playing/player/attributes"
 	"game/internal/plugins/playing/player/entities"
+	"game/internal/plugins/playing/scenario"
 	"image/color"
 	"log"
-	"math/rand/v2"
+	"math"
 
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/vector"
 )
 
@@ -21,15 +26,12 @@ type PlayerPlugin struct {
 
 	x, y float64
 
-	health           float64
-	width            float64
-	height           float64
-	speed            float64
-	damagePercent    float64
-	criticalChance   float64
-	collectionRadius float64
+	health float64
+	width  float64
+	height float64
+
+	attributes *attributes.Set
 
-	maxHealth        float64
 	healthRegenRate  float64
 	healthRegenDelay float64
 	healthRegenTimer float64
@@ -43,27 +45,28 @@ type PlayerPlugin struct {
 
 	DamageFlashTime float64
 
+	invulnerabilityDuration float64
+	invulnerabilityTimer    float64
+	blinkInterval           float64
+
+	knockbackForce float64
+	knockbackDecay float64
+	knockbackX     float64
+	knockbackY     float64
+
 	experience int
 	level      int
 
-	additionalDamagePercent float64
-	criticalMultiplier      float64
-
-	armor float64
+	attributesPerLevel map[attributes.Attribute]float64
 
-	healthIncrementPerLevel         float64
-	speedIncrementPerLevel          float64
-	damagePercentIncrementPerLevel  float64
-	armorIncrementPerLevel          float64
-	criticalChanceIncrementPerLevel float64
-	additionalDamagePercentPerLevel float64
+	dash *Dash
 
-	dashSpeed    float64
-	dashDuration float64
-	dashCooldown float64
-	dashTimer    float64
-	isDashing    bool
-	canDash      bool
+	revives               int
+	reviveHealthPercent   float64
+	reviveInvulnerability float64
+	shockwaveRadius       float64
+	shockwaveDuration     float64
+	shockwaveTimer        float64
 }
 
 var levelUpExperience = map[int]int{
@@ -79,41 +82,64 @@ var levelUpExperience = map[int]int{
 	10: 200,
 }
 
+var dashUpgradesByLevel = map[int]entities.DashUpgrade{
+	4:  {Cooldown: 0.2},
+	6:  {Charges: 1},
+	8:  {Duration: 0.05},
+	10: {Charges: 1},
+}
+
 func NewPlayerPlugin(plugins *core.PluginManager, c entities.Character) *PlayerPlugin {
 	return &PlayerPlugin{
-		playingPlugins:   plugins,
-		x:                400,
-		y:                300,
-		width:            32,
-		height:           48,
-		speed:            c.Speed,
-		experience:       0,
-		level:            1,
-		collectionRadius: 50.0,
+		playingPlugins: plugins,
+		x:              400,
+		y:              300,
+		width:          32,
+		height:         48,
+		experience:     0,
+		level:          1,
 
 		health:           c.Health,
-		maxHealth:        c.Health,
 		healthRegenRate:  5.0,
 		healthRegenDelay: 1.0,
 		healthRegenTimer: 0,
 
-		additionalDamagePercent: 10,
-		criticalMultiplier:      2.0,
-		armor:                   0,
-
-		healthIncrementPerLevel:         10.0,
-		speedIncrementPerLevel:          1.0,
-		damagePercentIncrementPerLevel:  5.0,
-		armorIncrementPerLevel:          1.0,
-		criticalChanceIncrementPerLevel: 0.5,
-		additionalDamagePercentPerLevel: 2.0,
-
-		dashSpeed:    500, // Dash speed multiplier
-		dashDuration: 0.2, // How long dash lasts
-		dashCooldown: 1.0, // Time between dashes
-		dashTimer:    0,
-		isDashing:    false,
-		canDash:      true,
+		attributes: attributes.NewSet(map[attributes.Attribute]float64{
+			attributes.MaxHealth:          c.Health,
+			attributes.Armor:              c.Armor,
+			attributes.Speed:              c.Speed,
+			attributes.CriticalChance:     c.CriticalChance,
+			attributes.CriticalMultiplier: 2.0,
+			attributes.DamagePercent:      10 + c.DamagePercent,
+			attributes.PickupRadius:       50.0,
+			attributes.CooldownReduction:  0,
+			attributes.Area:               1.0,
+			attributes.Duration:           1.0,
+			attributes.ProjectileCount:    0,
+		}),
+
+		attributesPerLevel: map[attributes.Attribute]float64{
+			attributes.MaxHealth:      10.0,
+			attributes.Speed:          1.0,
+			attributes.Armor:          1.0,
+			attributes.CriticalChance: 0.5,
+			attributes.DamagePercent:  2.0,
+		},
+
+		invulnerabilityDuration: 0.5,  // I-frames after being hit
+		blinkInterval:           0.08, // Sprite blink while invulnerable
+
+		knockbackForce: 400, // Initial knockback speed
+		knockbackDecay: 8,   // How fast knockback fades per second
+
+		// Speed, duration, recharge time per charge and charges
+		dash: NewDash(500, 0.2, 1.0, 1),
+
+		revives:               c.Revives,
+		reviveHealthPercent:   0.5, // Health restored on revive
+		reviveInvulnerability: 2.0,
+		shockwaveRadius:       700, // Enough to clear the screen
+		shockwaveDuration:     0.5,
 	}
 }
 
@@ -164,35 +190,36 @@ func (p *PlayerPlugin) Update() error {
 	// Get initial position
 	newX, newY := p.x, p.y
 
-	// Handle dash input and state
-	if ebiten.IsKeyPressed(ebiten.KeyShift) && p.canDash {
-		p.isDashing = true
-		p.canDash = false
-		p.dashTimer = 0
-	}
+	directionX, directionY := InputHandler(p)
+
+	// Dash towards the pressed direction, or the facing one when idle
+	if inpututil.IsKeyJustPressed(ebiten.KeyShift) {
+		dashX, dashY := directionX, directionY
 
-	// Update dash state
-	if !p.canDash {
-		p.dashTimer += p.kernel.DeltaTime
-		if p.dashTimer >= p.dashCooldown {
-			p.canDash = true
-			p.dashTimer = 0
+		if dashX == 0 && dashY == 0 {
+			dashX = -1
+			if p.facingRight {
+				dashX = 1
+			}
 		}
+
+		p.dash.Start(dashX, dashY)
 	}
 
-	// Calculate movement
-	currentSpeed := p.speed
-	if p.isDashing {
-		currentSpeed = p.dashSpeed
-		p.dashTimer += p.kernel.DeltaTime
+	p.dash.Update(p.kernel.DeltaTime)
+	p.updateAttributes(p.kernel.DeltaTime)
 
-		if p.dashTimer >= p.dashDuration {
-			p.isDashing = false
-		}
-	}
+	if p.dash.IsDashing() {
+		dashX, dashY := p.dash.Direction()
+
+		newX += dashX * p.dash.Speed * p.kernel.DeltaTime
+		newY += dashY * p.dash.Speed * p.kernel.DeltaTime
+	} else {
+		speed := p.GetSpeed()
 
-	// Apply movement with dash speed if active
-	newX, newY = InputHandler(p, newX, newY, currentSpeed)
+		newX += directionX * speed * p.kernel.DeltaTime
+		newY += directionY * speed * p.kernel.DeltaTime
+	}
 
 	// Update animation states
 	p.currentAnimation = p.idleAnimation
@@ -210,13 +237,32 @@ func (p *PlayerPlugin) Update() error {
 		p.currentAnimation.Update(p.kernel.DeltaTime)
 	}
 
-	// Update position
-	p.x, p.y = newX, newY
+	// Update position, knockback included, blocked by obstacles
+	p.move(
+		newX-p.x+p.knockbackX*p.kernel.DeltaTime,
+		newY-p.y+p.knockbackY*p.kernel.DeltaTime)
+
+	if p.currentAnimation != nil {
+		p.dash.Trail(p.kernel.DeltaTime, p.x, p.y, p.currentAnimation.GetCurrentFrame())
+	}
+
+	// Fade knockback
+	decay := math.Min(1, p.knockbackDecay*p.kernel.DeltaTime)
+	p.knockbackX -= p.knockbackX * decay
+	p.knockbackY -= p.knockbackY * decay
 
 	if p.DamageFlashTime > 0 {
 		p.DamageFlashTime -= p.kernel.DeltaTime
 	}
 
+	if p.invulnerabilityTimer > 0 {
+		p.invulnerabilityTimer -= p.kernel.DeltaTime
+	}
+
+	if p.shockwaveTimer > 0 {
+		p.shockwaveTimer -= p.kernel.DeltaTime
+	}
+
 	if p.experience >= levelUpExperience[p.level] &&
 		p.level < len(levelUpExperience)+1 {
 
@@ -267,6 +313,21 @@ func (p *PlayerPlugin) Draw(screen *ebiten.Image) {
 
 	}
 
+	p.dash.Draw(screen, cameraX, cameraY, p.width, p.height)
+
+	if p.shockwaveTimer > 0 {
+		progress := 1 - p.shockwaveTimer/p.shockwaveDuration
+
+		vector.StrokeCircle(
+			screen,
+			float32(screenX),
+			float32(screenY),
+			float32(p.shockwaveRadius*progress),
+			8,
+			color.RGBA{255, 230, 120, uint8(255 * (1 - progress))},
+			true)
+	}
+
 	drawInput := assets.DrawInput{
 		Width:  p.width,
 		Height: p.height,
@@ -274,11 +335,33 @@ func (p *PlayerPlugin) Draw(screen *ebiten.Image) {
 		Y:      screenY - p.height/2,
 	}
 
-	if p.currentAnimation != nil {
+	if p.currentAnimation != nil && p.isVisible() {
 		p.currentAnimation.Draw(screen, drawInput)
 	}
 }
 
+// move resolves the player movement against the scenario obstacles
+func (p *PlayerPlugin) move(dx, dy float64) {
+	scenarioPlugin := p.playingPlugins.GetPlugin("ScenarioSystem").(*scenario.ScenarioPlugin)
+
+	left, top := scenarioPlugin.Move(
+		p.x-p.width/2, p.y-p.height/2,
+		p.width, p.height,
+		dx, dy)
+
+	p.x = left + p.width/2
+	p.y = top + p.height/2
+}
+
+// isVisible makes the sprite blink while the player is invulnerable
+func (p *PlayerPlugin) isVisible() bool {
+	if p.invulnerabilityTimer <= 0 {
+		return true
+	}
+
+	return int(p.invulnerabilityTimer/p.blinkInterval)%2 == 0
+}
+
 func (p *PlayerPlugin) GetPosition() (float64, float64) {
 	return p.x, p.y
 }
@@ -286,16 +369,148 @@ func (p *PlayerPlugin) GetPosition() (float64, float64) {
 func (p *PlayerPlugin) DecreaseHealth(amount float64) {
 	p.health -= amount
 
-	if p.health < 0 {
+	if p.health <= 0 {
+		if p.revives > 0 {
+			p.revive()
+
+			return
+		}
+
 		p.health = 0
 		p.kernel.EventBus.Publish("GameOver", nil)
 	}
 }
 
+// revive spends a revive to bring the player back, clearing the screen with
+// a shockwave and granting some invulnerability
+func (p *PlayerPlugin) revive() {
+	p.revives--
+
+	p.health = p.GetMaxHealth() * p.reviveHealthPercent
+	p.invulnerabilityTimer = p.reviveInvulnerability
+
+	p.Shockwave()
+}
+
+// Shockwave clears everything hostile on the screen around the player
+func (p *PlayerPlugin) Shockwave() {
+	p.shockwaveTimer = p.shockwaveDuration
+
+	p.kernel.EventBus.Publish("Shockwave", entities.Shockwave{
+		X:      p.x,
+		Y:      p.y,
+		Radius: p.shockwaveRadius,
+	})
+}
+
+// Heal restores health up to the max health
+func (p *PlayerPlugin) Heal(amount float64) {
+	p.health = math.Min(p.GetMaxHealth(), p.health+amount)
+}
+
+func (p *PlayerPlugin) AddRevives(amount int) {
+	p.revives += amount
+}
+
+func (p *PlayerPlugin) GetRevives() int {
+	return p.revives
+}
+
+// Hit is the single entry point for anything hurting the player. Solid
+// attackers always push the player out of their bounds, but damage and
+// knockback are ignored while the player is invulnerable. Returns the damage
+// taken after the armor and whether the hit was applied.
+func (p *PlayerPlugin) Hit(hit entities.Hit) (float64, bool) {
+	if hit.Solid {
+		p.resolveCollision(hit)
+	}
+
+	if p.IsInvulnerable() {
+		return 0, false
+	}
+
+	// I-frames first, a revive triggered by this hit grants longer ones
+	p.invulnerabilityTimer = p.invulnerabilityDuration
+	p.DamageFlashTime = 0.3
+
+	amount := p.mitigate(hit.Damage)
+
+	p.kernel.EventBus.Publish(events.PlayerHurt, events.PlayerHurtEvent{
+		Source: hit.Source,
+		Amount: amount,
+		X:      hit.SourceX + hit.SourceWidth/2,
+		Y:      hit.SourceY + hit.SourceHeight/2,
+	})
+
+	p.DecreaseHealth(amount)
+
+	if hit.Knockback > 0 {
+		dx := p.x - (hit.SourceX + hit.SourceWidth/2)
+		dy := p.y - (hit.SourceY + hit.SourceHeight/2)
+		distance := math.Sqrt(dx*dx + dy*dy)
+
+		// Attacker exactly on top of the player, push backwards
+		if distance == 0 {
+			dx, distance = -1, 1
+			if !p.facingRight {
+				dx = 1
+			}
+		}
+
+		force := p.knockbackForce * hit.Knockback
+		p.knockbackX = dx / distance * force
+		p.knockbackY = dy / distance * force
+	}
+
+	return amount, true
+}
+
+func (p *PlayerPlugin) IsInvulnerable() bool {
+	return p.invulnerabilityTimer > 0 || p.dash.IsDashing()
+}
+
+// resolveCollision moves the player out of the attacker along the axis with
+// the smallest overlap, the obstacles stop the push like any movement
+func (p *PlayerPlugin) resolveCollision(hit entities.Hit) {
+	left := p.x - p.width/2
+	top := p.y - p.height/2
+
+	overlapX := math.Min(left+p.width, hit.SourceX+hit.SourceWidth) - math.Max(left, hit.SourceX)
+	overlapY := math.Min(top+p.height, hit.SourceY+hit.SourceHeight) - math.Max(top, hit.SourceY)
+
+	if overlapX <= 0 || overlapY <= 0 {
+		return
+	}
+
+	if overlapX < overlapY {
+		if p.x < hit.SourceX+hit.SourceWidth/2 {
+			p.move(-overlapX, 0)
+		} else {
+			p.move(overlapX, 0)
+		}
+	} else {
+		if p.y < hit.SourceY+hit.SourceHeight/2 {
+			p.move(0, -overlapY)
+		} else {
+			p.move(0, overlapY)
+		}
+	}
+}
+
 func (p *PlayerPlugin) GetSize() (float64, float64) {
 	return p.width, p.height
 }
 
+// GetHitbox returns the player box around its center position
+func (p *PlayerPlugin) GetHitbox() shapes.Shape {
+	return shapes.AABB{
+		X:      p.x - p.width/2,
+		Y:      p.y - p.height/2,
+		Width:  p.width,
+		Height: p.height,
+	}
+}
+
 func (p *PlayerPlugin) GetHealth() float64 {
 	return p.health
 }
@@ -317,45 +532,38 @@ func (p *PlayerPlugin) NextLevelPercentage() float64 {
 }
 
 func (p *PlayerPlugin) ApplyDamage(damage float64) {
-	// Aplicar a armadura para reduzir o dano
-	effectiveDamage := damage * (1 - p.armor/100)
-	p.DecreaseHealth(effectiveDamage)
+	p.DecreaseHealth(p.mitigate(damage))
 }
 
-func (p *PlayerPlugin) CalculateDamage(baseDamage float64) (float64, bool) {
-	isCriticalDamage := false
-	damage := baseDamage * (1 + p.additionalDamagePercent/100)
-
-	if rand.Float64() < p.criticalChance/100 {
-		damage *= p.criticalMultiplier
-		isCriticalDamage = true
-	}
-
-	return damage, isCriticalDamage
+// mitigate applies the armor to reduce the damage
+func (p *PlayerPlugin) mitigate(damage float64) float64 {
+	return damage * (1 - math.Min(p.GetArmor(), 90)/100)
 }
 
-func (p *PlayerPlugin) GetAdditionalDamagePercent() float64 {
-	return p.additionalDamagePercent
+// CalculateDamage boosts the damage by the player damage percent, the
+// critical hits are rolled by the combat pipeline
+func (p *PlayerPlugin) CalculateDamage(baseDamage float64) float64 {
+	return baseDamage * (1 + p.GetDamagePercent()/100)
 }
 
 func (p *PlayerPlugin) GetArmor() float64 {
-	return p.armor
+	return p.attributes.Value(attributes.Armor)
 }
 
 func (p *PlayerPlugin) GetSpeed() float64 {
-	return p.speed
+	return p.attributes.Value(attributes.Speed)
 }
 
 func (p *PlayerPlugin) GetCriticalChance() float64 {
-	return p.criticalChance
+	return p.attributes.Value(attributes.CriticalChance)
 }
 
 func (p *PlayerPlugin) GetDamagePercent() float64 {
-	return p.damagePercent
+	return p.attributes.Value(attributes.DamagePercent)
 }
 
 func (p *PlayerPlugin) GetMaxHealth() float64 {
-	return p.maxHealth
+	return p.attributes.Value(attributes.MaxHealth)
 }
 
 func (p *PlayerPlugin) GetHealthRegenRate() float64 {
@@ -371,17 +579,36 @@ func (p *PlayerPlugin) GetHealthRegenTimer() float64 {
 }
 
 func (p *PlayerPlugin) increaseAttributes() {
-	p.maxHealth += p.healthIncrementPerLevel
-	p.health = p.maxHealth
-	p.speed += p.speedIncrementPerLevel
-	p.damagePercent += p.damagePercentIncrementPerLevel
-	p.armor += p.armorIncrementPerLevel
-	p.criticalChance += p.criticalChanceIncrementPerLevel
-	p.additionalDamagePercent += p.additionalDamagePercentPerLevel
+	for attribute, increment := range p.attributesPerLevel {
+		p.AddModifier(attributes.Modifier{
+			Source:    "level",
+			Attribute: attribute,
+			Kind:      attributes.Additive,
+			Value:     increment * float64(p.level-1),
+		})
+	}
+
+	p.health = p.GetMaxHealth()
+
+	if upgrade, exists := dashUpgradesByLevel[p.level]; exists {
+		p.dash.Upgrade(upgrade)
+	}
+}
+
+func (p *PlayerPlugin) UpgradeDash(upgrade entities.DashUpgrade) {
+	p.dash.Upgrade(upgrade)
+}
+
+func (p *PlayerPlugin) IsDashing() bool {
+	return p.dash.IsDashing()
 }
 
-func (p *PlayerPlugin) GetDashTimer() float64 {
-	return p.dashTimer
+func (p *PlayerPlugin) GetDashCharges() (int, int) {
+	return p.dash.Charges()
+}
+
+func (p *PlayerPlugin) GetDashRechargeProgress() float64 {
+	return p.dash.RechargeProgress()
 }
 
 func (p *PlayerPlugin) GetNextLevelExperience() int {
@@ -389,5 +616,49 @@ func (p *PlayerPlugin) GetNextLevelExperience() int {
 }
 
 func (p *PlayerPlugin) GetCollectionRadius() float64 {
-	return p.collectionRadius
+	return p.attributes.Value(attributes.PickupRadius)
+}
+
+func (p *PlayerPlugin) GetAttribute(a attributes.Attribute) float64 {
+	return p.attributes.Value(a)
+}
+
+func (p *PlayerPlugin) GetAttributes() *attributes.Set {
+	return p.attributes
+}
+
+// AddModifier adds or replaces a modifier, a max health increase also heals
+// the player by the same amount
+func (p *PlayerPlugin) AddModifier(m attributes.Modifier) {
+	maxHealth := p.GetMaxHealth()
+
+	p.attributes.AddModifier(m)
+
+	p.adjustHealth(maxHealth)
+}
+
+func (p *PlayerPlugin) RemoveModifiers(source string) {
+	maxHealth := p.GetMaxHealth()
+
+	p.attributes.RemoveSource(source)
+
+	p.adjustHealth(maxHealth)
+}
+
+func (p *PlayerPlugin) updateAttributes(deltaTime float64) {
+	maxHealth := p.GetMaxHealth()
+
+	p.attributes.Update(deltaTime)
+
+	p.adjustHealth(maxHealth)
+}
+
+func (p *PlayerPlugin) adjustHealth(previousMaxHealth float64) {
+	maxHealth := p.GetMaxHealth()
+
+	if maxHealth > previousMaxHealth {
+		p.health += maxHealth - previousMaxHealth
+	}
+
+	p.health = math.Min(p.health, maxHealth)
 }
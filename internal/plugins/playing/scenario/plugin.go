@@ -7,6 +7,7 @@ import (
 	"game/internal/plugins/playing/camera"
 	"image/color"
 	"log"
+	"math"
 	"math/rand"
 
 	"github.com/hajimehoshi/ebiten/v2"
@@ -20,6 +21,10 @@ const (
 	TileRock
 )
 
+// activeChunkRadius is how many chunks around the camera are animated and
+// used for enemy pathfinding
+const activeChunkRadius = 2
+
 var tileColors = map[TileType]color.Color{
 	TileGround: color.RGBA{34, 139, 34, 255},   // Green
 	TileTree:   color.RGBA{0, 100, 0, 255},     // Dark Green
@@ -32,6 +37,12 @@ type MapTile struct {
 	Animated *assets.Animation
 }
 
+// Rect is an axis aligned area in world coordinates
+type Rect struct {
+	X, Y          float64
+	Width, Height float64
+}
+
 type Chunk struct {
 	Tiles     [][]*MapTile
 	Generated bool
@@ -148,24 +159,15 @@ func (sp *ScenarioPlugin) Draw(screen *ebiten.Image) {
 	cameraX, cameraY := cameraPlugin.GetPosition()
 
 	// Calculate visible chunks
-	startChunkX := int(cameraX) / (sp.chunkSize * sp.tileSize)
-	startChunkY := int(cameraY) / (sp.chunkSize * sp.tileSize)
+	startChunkX, startChunkY := sp.chunkAt(cameraX, cameraY)
 
 	chunksX := (constants.ScreenWidth / (sp.chunkSize * sp.tileSize)) + 2
 	chunksY := (constants.ScreenHeight / (sp.chunkSize * sp.tileSize)) + 2
 
 	// Generate and draw visible chunks
 	for cx := startChunkX - 1; cx <= startChunkX+chunksX; cx++ {
-		if sp.chunks[cx] == nil {
-			sp.chunks[cx] = make(map[int]*Chunk)
-		}
-
 		for cy := startChunkY - 1; cy <= startChunkY+chunksY; cy++ {
-			if sp.chunks[cx][cy] == nil {
-				sp.chunks[cx][cy] = sp.generateChunk(cx, cy)
-			}
-
-			chunk := sp.chunks[cx][cy]
+			chunk := sp.chunk(cx, cy)
 			for x := 0; x < sp.chunkSize; x++ {
 				for y := 0; y < sp.chunkSize; y++ {
 					worldX := cx*sp.chunkSize*sp.tileSize + x*sp.tileSize
@@ -194,7 +196,9 @@ func (sp *ScenarioPlugin) Draw(screen *ebiten.Image) {
 		}
 	}
 
-	go sp.cleanupFarChunks(startChunkX, startChunkY)
+	// Chunks are queried by the other plugins during update, cleaning them up
+	// concurrently would race with those queries
+	sp.cleanupFarChunks(startChunkX, startChunkY)
 }
 
 func (sp *ScenarioPlugin) cleanupFarChunks(centerX, centerY int) {
@@ -221,12 +225,11 @@ func (sp *ScenarioPlugin) Update() error {
 	}
 
 	cameraX, cameraY := cameraPlugin.GetPosition()
-	startChunkX := int(cameraX) / (sp.chunkSize * sp.tileSize)
-	startChunkY := int(cameraY) / (sp.chunkSize * sp.tileSize)
+	startChunkX, startChunkY := sp.chunkAt(cameraX, cameraY)
 
 	for cx := range sp.chunks {
 		for cy, chunk := range sp.chunks[cx] {
-			if abs(cx-startChunkX) <= 2 && abs(cy-startChunkY) <= 2 {
+			if abs(cx-startChunkX) <= activeChunkRadius && abs(cy-startChunkY) <= activeChunkRadius {
 				for x := range chunk.Tiles {
 					for y := range chunk.Tiles[x] {
 						if chunk.Tiles[x][y].Animated != nil {
@@ -248,16 +251,141 @@ func abs(x int) int {
 	return x
 }
 
+// chunk returns the chunk at the chunk coordinates, generating it when needed
+func (sp *ScenarioPlugin) chunk(cx, cy int) *Chunk {
+	if sp.chunks[cx] == nil {
+		sp.chunks[cx] = make(map[int]*Chunk)
+	}
+
+	if sp.chunks[cx][cy] == nil {
+		sp.chunks[cx][cy] = sp.generateChunk(cx, cy)
+	}
+
+	return sp.chunks[cx][cy]
+}
+
+func (sp *ScenarioPlugin) chunkAt(worldX, worldY float64) (int, int) {
+	chunkWorldSize := float64(sp.chunkSize * sp.tileSize)
+
+	return int(math.Floor(worldX / chunkWorldSize)), int(math.Floor(worldY / chunkWorldSize))
+}
+
+func (sp *ScenarioPlugin) TileSize() float64 {
+	return float64(sp.tileSize)
+}
+
+// TileAt returns the tile covering the world position
+func (sp *ScenarioPlugin) TileAt(worldX, worldY float64) *MapTile {
+	return sp.tile(
+		int(math.Floor(worldX/float64(sp.tileSize))),
+		int(math.Floor(worldY/float64(sp.tileSize))))
+}
+
+// tile returns the tile at global tile coordinates
+func (sp *ScenarioPlugin) tile(tileX, tileY int) *MapTile {
+	cx := floorDiv(tileX, sp.chunkSize)
+	cy := floorDiv(tileY, sp.chunkSize)
+
+	return sp.chunk(cx, cy).Tiles[tileX-cx*sp.chunkSize][tileY-cy*sp.chunkSize]
+}
+
+// TileCoords returns the global tile coordinates covering the world position
+func (sp *ScenarioPlugin) TileCoords(worldX, worldY float64) (int, int) {
+	return int(math.Floor(worldX / float64(sp.tileSize))),
+		int(math.Floor(worldY / float64(sp.tileSize)))
+}
+
+// IsTileWalkableAt tells whether the tile at global tile coordinates is
+// walkable
+func (sp *ScenarioPlugin) IsTileWalkableAt(tileX, tileY int) bool {
+	return sp.tile(tileX, tileY).Walkable
+}
+
+// ActiveTileBounds returns the inclusive tile range of the chunks kept
+// active around the world position
+func (sp *ScenarioPlugin) ActiveTileBounds(worldX, worldY float64) (int, int, int, int) {
+	cx, cy := sp.chunkAt(worldX, worldY)
+
+	minX := (cx - activeChunkRadius) * sp.chunkSize
+	minY := (cy - activeChunkRadius) * sp.chunkSize
+	maxX := (cx+activeChunkRadius+1)*sp.chunkSize - 1
+	maxY := (cy+activeChunkRadius+1)*sp.chunkSize - 1
+
+	return minX, minY, maxX, maxY
+}
+
 func (sp *ScenarioPlugin) IsTileWalkable(worldX, worldY float64) bool {
-	chunkX := int(worldX) / (sp.chunkSize * sp.tileSize)
-	chunkY := int(worldY) / (sp.chunkSize * sp.tileSize)
+	return sp.TileAt(worldX, worldY).Walkable
+}
+
+// SolidRectsIn returns the bounds of every non walkable tile overlapping the
+// area
+func (sp *ScenarioPlugin) SolidRectsIn(x, y, width, height float64) []Rect {
+	var rects []Rect
+
+	tileSize := float64(sp.tileSize)
+
+	startX := int(math.Floor(x / tileSize))
+	startY := int(math.Floor(y / tileSize))
+	endX := int(math.Ceil((x+width)/tileSize)) - 1
+	endY := int(math.Ceil((y+height)/tileSize)) - 1
+
+	for tx := startX; tx <= endX; tx++ {
+		for ty := startY; ty <= endY; ty++ {
+			if !sp.tile(tx, ty).Walkable {
+				rects = append(rects, Rect{
+					X:      float64(tx) * tileSize,
+					Y:      float64(ty) * tileSize,
+					Width:  tileSize,
+					Height: tileSize,
+				})
+			}
+		}
+	}
+
+	return rects
+}
+
+func (sp *ScenarioPlugin) IsAreaWalkable(x, y, width, height float64) bool {
+	return len(sp.SolidRectsIn(x, y, width, height)) == 0
+}
+
+// Move resolves a movement of the area (top-left based) against the solid
+// tiles, one axis at a time so movers slide along obstacles. Solids the area
+// already overlaps are ignored so a mover can always walk out of them.
+func (sp *ScenarioPlugin) Move(x, y, width, height, dx, dy float64) (float64, float64) {
+	const epsilon = 0.001
+
+	newX := x + dx
+	if dx != 0 {
+		for _, r := range sp.SolidRectsIn(newX, y, width, height) {
+			if dx > 0 && r.X >= x+width-epsilon {
+				newX = math.Min(newX, r.X-width)
+			} else if dx < 0 && r.X+r.Width <= x+epsilon {
+				newX = math.Max(newX, r.X+r.Width)
+			}
+		}
+	}
 
-	if sp.chunks[chunkX] == nil || sp.chunks[chunkX][chunkY] == nil {
-		return false
+	newY := y + dy
+	if dy != 0 {
+		for _, r := range sp.SolidRectsIn(newX, newY, width, height) {
+			if dy > 0 && r.Y >= y+height-epsilon {
+				newY = math.Min(newY, r.Y-height)
+			} else if dy < 0 && r.Y+r.Height <= y+epsilon {
+				newY = math.Max(newY, r.Y+r.Height)
+			}
+		}
 	}
 
-	tileX := (int(worldX) / sp.tileSize) % sp.chunkSize
-	tileY := (int(worldY) / sp.tileSize) % sp.chunkSize
+	return newX, newY
+}
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
 
-	return sp.chunks[chunkX][chunkY].Tiles[tileX][tileY].Walkable
+	return q
 }
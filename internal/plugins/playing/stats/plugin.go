@@ -5,6 +5,7 @@ import (
 	"game/internal/assets"
 	"game/internal/constants"
 	"game/internal/core"
+	"game/internal/core/eventbus"
 	"game/internal/plugins"
 	"image/color"
 	"log"
@@ -13,11 +14,18 @@ import (
 	"github.com/hajimehoshi/ebiten/v2/text"
 	"github.com/hajimehoshi/ebiten/v2/vector"
 	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
 	"golang.org/x/image/font/gofont/goregular"
 	"golang.org/x/image/font/opentype"
 
 	abilityplugin "game/internal/plugins/playing/ability"
 	"game/internal/plugins/playing/camera"
+	"game/internal/plugins/playing/combat/events"
+	"game/internal/plugins/playing/combatlog"
+	enemyentities "game/internal/plugins/playing/enemy/entities"
+	"game/internal/plugins/playing/passive"
+	"game/internal/plugins/playing/pickup"
+	"game/internal/plugins/playing/player/attributes"
 )
 
 type StatsPlugin struct {
@@ -31,6 +39,9 @@ type StatsPlugin struct {
 
 	showStats     bool
 	showStatsTime float64
+
+	kills        int
+	subscription eventbus.Subscription
 }
 
 func NewStatsPlugin(plugins *core.PluginManager) *StatsPlugin {
@@ -46,6 +57,10 @@ func (sp *StatsPlugin) ID() string {
 func (sp *StatsPlugin) Init(kernel *core.GameKernel) error {
 	sp.kernel = kernel
 
+	sp.subscription = kernel.EventBus.Subscribe(events.EnemyKilled, func(interface{}) {
+		sp.kills++
+	})
+
 	tt, err := opentype.Parse(goregular.TTF)
 	if err != nil {
 		log.Fatal(err)
@@ -73,6 +88,11 @@ func (sp *StatsPlugin) Init(kernel *core.GameKernel) error {
 	return nil
 }
 
+// Close stops counting the kills once the run ends
+func (sp *StatsPlugin) Close() {
+	sp.kernel.EventBus.Unsubscribe(sp.subscription)
+}
+
 func (sp *StatsPlugin) Update() error {
 	if ebiten.IsKeyPressed(ebiten.KeyTab) {
 		sp.showStatsTime = 0.1
@@ -92,57 +112,8 @@ func (sp *StatsPlugin) Draw(screen *ebiten.Image) {
 	playerPlugin := sp.playingPlugins.GetPlugin("PlayerSystem").(plugins.PlayerPlugin)
 
 	if sp.showStats {
-		playerPower := playerPlugin.GetHealth()
-		text.Draw(screen, fmt.Sprintf("Life: %.0f", playerPower), sp.gameFont, 10, 30, color.White)
-
-		playerLevel := playerPlugin.GetLevel()
-		text.Draw(screen, fmt.Sprintf("Level: %.0f", playerLevel), sp.gameFont, 10, 60, color.White)
-
-		playerNextLevelPercentage := playerPlugin.NextLevelPercentage()
-		percentageText := fmt.Sprintf("Next Level: %.0f%%", playerNextLevelPercentage*100)
-		text.Draw(screen, percentageText, sp.gameFont, 10, 90, color.White)
-
-		playerGetArmor := playerPlugin.GetArmor()
-		armorText := fmt.Sprintf("Armor: %.0f%%", playerGetArmor)
-		text.Draw(screen, armorText, sp.gameFont, 10, 120, color.White)
-
-		playerGetDamagePercent := playerPlugin.GetDamagePercent()
-		damagePercentage := fmt.Sprintf("Damage Percentage: %.0f%%", playerGetDamagePercent)
-		text.Draw(screen, damagePercentage, sp.gameFont, 10, 150, color.White)
-
-		playerGetSpeed := playerPlugin.GetSpeed()
-		speedText := fmt.Sprintf("Speed: %.0f%%", playerGetSpeed)
-		text.Draw(screen, speedText, sp.gameFont, 10, 180, color.White)
-
-		playerGetHealthRegenRate := playerPlugin.GetHealthRegenRate()
-		healthRegenRateText := fmt.Sprintf("Health Regen Rate: %.0f%%", playerGetHealthRegenRate)
-		text.Draw(screen, healthRegenRateText, sp.gameFont, 10, 210, color.White)
-
-		playerGetHealthRegenDelay := playerPlugin.GetHealthRegenDelay()
-		healthRegenDelayText := fmt.Sprintf("Health Regen Delay: %.0f%%", playerGetHealthRegenDelay)
-		text.Draw(screen, healthRegenDelayText, sp.gameFont, 10, 240, color.White)
-
-		playerGetAdditionalDamagePercent := playerPlugin.GetAdditionalDamagePercent()
-		additionalDamagePercentText := fmt.Sprintf("Additional Damage Percent: %.0f%%", playerGetAdditionalDamagePercent)
-		text.Draw(screen, additionalDamagePercentText, sp.gameFont, 10, 270, color.White)
-
-		playerGetCriticalChance := playerPlugin.GetCriticalChance()
-		criticalChanceText := fmt.Sprintf("Critical Chance: %.0f%%", playerGetCriticalChance)
-		text.Draw(screen, criticalChanceText, sp.gameFont, 10, 300, color.White)
-
-		abilities := sp.playingPlugins.GetPlugin("AbilitySystem")
-
-		playerAbilities := abilities.(*abilityplugin.AbilityPlugin).GetAcquiredAbilities()
-
-		for i, ability := range playerAbilities {
-			ability := fmt.Sprintf("Ability: %s, Level: %d", ability.ID(), ability.CurrentLevel())
-			text.Draw(screen, ability, sp.gameFont, 10, 330+(i*30), color.White)
-		}
-
-		// exibir o timer para o proximo dash do player em millisegundos
-		playerDashTimer := playerPlugin.GetDashTimer()
-		dashTimerText := fmt.Sprintf("Dash Cooldown: %.2fms", playerDashTimer)
-		text.Draw(screen, dashTimerText, sp.gameFont, 10, 330+(len(playerAbilities)*30), color.White)
+		sp.drawStats(screen, playerPlugin)
+		sp.drawCombatLog(screen)
 	}
 
 	currentHealth := playerPlugin.GetHealth()
@@ -219,6 +190,22 @@ func (sp *StatsPlugin) Draw(screen *ebiten.Image) {
 		},
 	)
 
+	sp.drawDashCharges(screen, playerPlugin, centerX, healthBarPosition+barHeight+expbarheight+2, barWidth)
+	sp.drawRevives(screen, playerPlugin, centerX+barWidth+3, healthBarPosition)
+
+	enemyPlugin := sp.playingPlugins.GetPlugin("EnemySystem").(plugins.EnemyPlugin)
+	for i, boss := range enemyPlugin.GetBosses() {
+		sp.drawBossHealth(screen, boss, 60+float64(i)*35)
+	}
+
+	pickupPlugin := sp.playingPlugins.GetPlugin("PickupSystem").(*pickup.PickupPlugin)
+	text.Draw(screen,
+		fmt.Sprintf("Gold: %d  Kills: %d", pickupPlugin.GetGold(), sp.kills),
+		sp.gameFont,
+		constants.ScreenWidth-200,
+		40,
+		color.RGBA{255, 215, 0, 255})
+
 	statsPanelWidth := float32(300.0)
 	statsPanelHeight := float32(50.0)
 
@@ -230,3 +217,234 @@ func (sp *StatsPlugin) Draw(screen *ebiten.Image) {
 		color.RGBA{255, 255, 255, 255},
 		true)
 }
+
+// drawStats draws the Tab stats screen, every attribute is followed by the
+// modifiers that make up its value
+func (sp *StatsPlugin) drawStats(screen *ebiten.Image, playerPlugin plugins.PlayerPlugin) {
+	y := 30
+	line := func(s string, c color.Color) {
+		text.Draw(screen, s, sp.gameFont, 10, y, c)
+		y += 20
+	}
+
+	line(fmt.Sprintf("Life: %.0f", playerPlugin.GetHealth()), color.White)
+	line(fmt.Sprintf("Level: %.0f", playerPlugin.GetLevel()), color.White)
+	line(fmt.Sprintf("Next Level: %.0f%%", playerPlugin.NextLevelPercentage()*100), color.White)
+
+	playerAttributes := playerPlugin.GetAttributes()
+
+	for _, attribute := range attributes.All {
+		value := playerAttributes.Value(attribute)
+		base := playerAttributes.Base(attribute)
+
+		breakdown := playerAttributes.Breakdown(attribute)
+
+		line(fmt.Sprintf("%s: %s", attribute, formatAttribute(attribute, value)), color.White)
+
+		if len(breakdown) == 0 {
+			continue
+		}
+
+		line("    base "+formatAttribute(attribute, base), color.RGBA{180, 180, 180, 255})
+
+		for _, m := range breakdown {
+			line("    "+formatModifier(m), color.RGBA{180, 180, 180, 255})
+		}
+	}
+
+	line(fmt.Sprintf("Health Regen Rate: %.0f%%", playerPlugin.GetHealthRegenRate()), color.White)
+	line(fmt.Sprintf("Health Regen Delay: %.0f%%", playerPlugin.GetHealthRegenDelay()), color.White)
+
+	abilities := sp.playingPlugins.GetPlugin("AbilitySystem")
+
+	playerAbilities := abilities.(*abilityplugin.AbilityPlugin).GetAcquiredAbilities()
+
+	for _, ability := range playerAbilities {
+		line(fmt.Sprintf("Ability: %s, Level: %d", ability.ID(), ability.CurrentLevel()), color.White)
+	}
+
+	passivePlugin := sp.playingPlugins.GetPlugin("PassiveSystem").(*passive.PassivePlugin)
+
+	for _, item := range passivePlugin.GetAcquiredItems() {
+		line(fmt.Sprintf("Item: %s, Level: %d", item.Name, item.Level), color.White)
+	}
+
+	dashCharges, dashMaxCharges := playerPlugin.GetDashCharges()
+	line(fmt.Sprintf("Dash: %d/%d charges", dashCharges, dashMaxCharges), color.White)
+	line(fmt.Sprintf("Revives: %d", playerPlugin.GetRevives()), color.White)
+}
+
+// drawCombatLog draws the live damage of every ability next to the Tab stats
+func (sp *StatsPlugin) drawCombatLog(screen *ebiten.Image) {
+	combatLogPlugin := sp.playingPlugins.GetPlugin("CombatLogSystem").(*combatlog.CombatLogPlugin)
+	summary := combatLogPlugin.Summary()
+
+	// Monospaced so the padded columns line up
+	y := 30
+	line := func(s string, c color.Color) {
+		text.Draw(screen, s, basicfont.Face7x13, 320, y, c)
+		y += 16
+	}
+
+	line("Time: "+combatlog.FormatDuration(summary.Duration), color.White)
+
+	line("Damage dealt", color.RGBA{255, 215, 0, 255})
+	for _, stats := range summary.Abilities {
+		line(stats.String(), color.White)
+	}
+
+	line("Damage taken", color.RGBA{255, 80, 80, 255})
+	for _, stats := range summary.Taken {
+		line(stats.String(), color.White)
+	}
+}
+
+func formatAttribute(attribute attributes.Attribute, value float64) string {
+	switch attribute {
+	case attributes.Armor,
+		attributes.CriticalChance,
+		attributes.DamagePercent,
+		attributes.CooldownReduction:
+
+		return fmt.Sprintf("%.1f%%", value)
+
+	case attributes.CriticalMultiplier,
+		attributes.Area,
+		attributes.Duration:
+
+		return fmt.Sprintf("x%.2f", value)
+	}
+
+	return fmt.Sprintf("%.0f", value)
+}
+
+func formatModifier(m attributes.Modifier) string {
+	value := ""
+
+	switch m.Kind {
+	case attributes.Additive:
+		value = fmt.Sprintf("%+.1f", m.Value)
+	case attributes.Multiplicative:
+		value = fmt.Sprintf("%+.0f%%", m.Value*100)
+	}
+
+	if m.Duration > 0 {
+		return fmt.Sprintf("%s %s (%.0fs)", m.Source, value, m.Remaining())
+	}
+
+	return fmt.Sprintf("%s %s", m.Source, value)
+}
+
+// drawDashCharges draws one pip per dash charge below the player bars, the
+// next charge to be ready fills up while recharging
+func (sp *StatsPlugin) drawDashCharges(
+	screen *ebiten.Image,
+	playerPlugin plugins.PlayerPlugin,
+	x, y, width float64) {
+
+	charges, maxCharges := playerPlugin.GetDashCharges()
+	if maxCharges == 0 {
+		return
+	}
+
+	gap := 2.0
+	pipHeight := 3.0
+	pipWidth := (width - gap*float64(maxCharges-1)) / float64(maxCharges)
+
+	for i := 0; i < maxCharges; i++ {
+		pipX := x + float64(i)*(pipWidth+gap)
+
+		vector.DrawFilledRect(
+			screen,
+			float32(pipX),
+			float32(y),
+			float32(pipWidth),
+			float32(pipHeight),
+			color.RGBA{60, 60, 60, 255},
+			true,
+		)
+
+		fill := 0.0
+		if i < charges {
+			fill = 1
+		} else if i == charges {
+			fill = playerPlugin.GetDashRechargeProgress()
+		}
+
+		vector.DrawFilledRect(
+			screen,
+			float32(pipX),
+			float32(y),
+			float32(pipWidth*fill),
+			float32(pipHeight),
+			color.RGBA{120, 200, 255, 255},
+			true,
+		)
+	}
+}
+
+// drawRevives draws one gold pip per remaining revive next to the health bar
+func (sp *StatsPlugin) drawRevives(
+	screen *ebiten.Image,
+	playerPlugin plugins.PlayerPlugin,
+	x, y float64) {
+
+	pipSize := 4.0
+
+	for i := 0; i < playerPlugin.GetRevives(); i++ {
+		vector.DrawFilledRect(
+			screen,
+			float32(x),
+			float32(y+float64(i)*(pipSize+1)),
+			float32(pipSize),
+			float32(pipSize),
+			color.RGBA{255, 200, 40, 255},
+			true,
+		)
+	}
+}
+
+// drawBossHealth draws a wide health bar at the top of the screen with the
+// boss name and a marker at every phase threshold
+func (sp *StatsPlugin) drawBossHealth(screen *ebiten.Image, boss *enemyentities.Enemy, y float64) {
+	barWidth := 500.0
+	barHeight := 12.0
+	x := constants.ScreenWidth/2 - barWidth/2
+
+	text.Draw(screen, boss.Template.Name, sp.gameFont, int(x), int(y)-4, color.White)
+
+	vector.DrawFilledRect(
+		screen,
+		float32(x),
+		float32(y),
+		float32(barWidth),
+		float32(barHeight),
+		color.RGBA{60, 0, 60, 255},
+		true,
+	)
+
+	vector.DrawFilledRect(
+		screen,
+		float32(x),
+		float32(y),
+		float32(barWidth*boss.Health/boss.MaxHealth),
+		float32(barHeight),
+		color.RGBA{200, 40, 200, 255},
+		true,
+	)
+
+	for _, phase := range boss.Template.Boss.Phases[1:] {
+		markerX := x + barWidth*phase.Threshold
+
+		vector.StrokeLine(
+			screen,
+			float32(markerX),
+			float32(y),
+			float32(markerX),
+			float32(y+barHeight),
+			2,
+			color.White,
+			true,
+		)
+	}
+}
@@ -2,15 +2,45 @@ package main
 
 import (
 	"fmt"
+	"game/internal/config"
 	"game/internal/core"
 	"game/internal/game"
 	"game/internal/game/states"
+	"game/internal/plugins/playing/ability/levels"
+	"game/internal/plugins/playing/enemy/affixes"
+	"game/internal/plugins/playing/enemy/director"
+	"game/internal/plugins/playing/enemy/projectiles"
+	"game/internal/plugins/playing/enemy/templates"
 	"log"
 
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
 func main() {
+	if err := projectiles.Load(); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := templates.Load(); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := affixes.Load(); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := director.Load(); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := levels.Load(); err != nil {
+		log.Fatal(err)
+	}
+
+	if _, exists := director.Timelines[config.Timeline()]; !exists {
+		log.Fatalf("unknown wave timeline %q", config.Timeline())
+	}
+
 	kernel := core.NewGameKernel()
 
 	gameInstance := game.NewGame(kernel)